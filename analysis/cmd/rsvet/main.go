@@ -0,0 +1,19 @@
+// Command rsvet runs the `rsvet` analyzer, which reports unchecked
+// `Unwrap` and `Expect` calls and discarded Results:
+//
+//	go run github.com/jwhittle933/rs.go/analysis/cmd/rsvet ./...
+//
+// It accepts the usual flags of a `go/analysis` single-checker,
+// including -fix and -json, and can be used with `go vet -vettool`.
+// It lives in its own module, with the analyzer, so that the core
+// packages stay free of dependencies.
+package main
+
+import (
+	"github.com/jwhittle933/rs.go/analysis/rsvet"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(rsvet.Analyzer)
+}
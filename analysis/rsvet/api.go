@@ -0,0 +1,186 @@
+// Package rsvet defines an `analysis.Analyzer` that keeps the panicking
+// escape hatches of `option` and `result` under control. It reports:
+//
+//   - calls to `Unwrap`, `UnwrapErr`, `Expect`, `ExpectErr`, `Expectf`,
+//     or `ExpectErrf` on an `Option` or `Result` that are not guarded by
+//     a check of the same value with `IsOk`, `IsErr`, `IsSome`, or
+//     `IsNone`, and are not in a function that recovers from panics;
+//   - `Result` values that are computed and then discarded.
+//
+// A call is guarded if it sits inside an `if` whose condition checks
+// the value, or follows such an `if` in the same or an enclosing block,
+// as in the early-return style:
+//
+//	if res.IsErr() {
+//		return res.UnwrapErr()
+//	}
+//	v := res.Unwrap()
+//
+// The check is syntactic: the receiver must be spelled the same way in
+// the check and the call.
+package rsvet
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	optionPath = "github.com/jwhittle933/rs.go/option"
+	resultPath = "github.com/jwhittle933/rs.go/result"
+)
+
+// Analyzer reports unchecked unwraps and discarded Results.
+var Analyzer = &analysis.Analyzer{
+	Name:     "rsvet",
+	Doc:      "report unchecked Unwrap/Expect calls and discarded Results",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+var (
+	unwraps = map[string]bool{"Unwrap": true, "UnwrapErr": true, "Expect": true, "ExpectErr": true, "Expectf": true, "ExpectErrf": true}
+	checks  = map[string]bool{"IsOk": true, "IsErr": true, "IsSome": true, "IsNone": true}
+)
+
+func run(pass *analysis.Pass) (any, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	filter := []ast.Node{(*ast.CallExpr)(nil), (*ast.ExprStmt)(nil)}
+	ins.WithStack(filter, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.ExprStmt:
+			if call, ok := n.X.(*ast.CallExpr); ok && isType(pass.TypesInfo.TypeOf(call), resultPath, "Result") {
+				pass.Reportf(n.Pos(), "Result of %s is discarded", types.ExprString(call.Fun))
+			}
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok || !unwraps[sel.Sel.Name] || !isWrapper(pass.TypesInfo.TypeOf(sel.X)) {
+				return true
+			}
+
+			recv := types.ExprString(sel.X)
+			if !guarded(recv, stack) && !recovers(stack) {
+				pass.Reportf(n.Pos(), "%s.%s is not guarded by a check of %s", recv, sel.Sel.Name, recv)
+			}
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// isWrapper reports whether `t` is an `Option` or `Result`, or a
+// pointer to one.
+func isWrapper(t types.Type) bool {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+
+	return isType(t, optionPath, "Option") || isType(t, resultPath, "Result")
+}
+
+func isType(t types.Type, path, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == path && obj.Name() == name
+}
+
+// guarded reports whether the node at the top of `stack` is inside an
+// `if` that checks `recv`, or follows one in an enclosing block.
+func guarded(recv string, stack []ast.Node) bool {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch parent := stack[i].(type) {
+		case *ast.IfStmt:
+			if checksRecv(parent.Cond, recv) {
+				return true
+			}
+		case *ast.BlockStmt:
+			for _, stmt := range parent.List {
+				if stmt == stack[i+1] {
+					break
+				}
+
+				if ifs, ok := stmt.(*ast.IfStmt); ok && checksRecv(ifs.Cond, recv) {
+					return true
+				}
+			}
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		}
+	}
+
+	return false
+}
+
+// checksRecv reports whether `cond` calls a check method on `recv`.
+func checksRecv(cond ast.Expr, recv string) bool {
+	found := false
+	ast.Inspect(cond, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return !found
+		}
+
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && checks[sel.Sel.Name] && types.ExprString(sel.X) == recv {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// recovers reports whether the innermost function in `stack` defers a
+// call that recovers from panics.
+func recovers(stack []ast.Node) bool {
+	var body *ast.BlockStmt
+	for i := len(stack) - 1; i >= 0 && body == nil; i-- {
+		switch fn := stack[i].(type) {
+		case *ast.FuncDecl:
+			body = fn.Body
+		case *ast.FuncLit:
+			body = fn.Body
+		}
+	}
+
+	if body == nil {
+		return false
+	}
+
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if found {
+			return false
+		}
+
+		if d, ok := n.(*ast.DeferStmt); ok {
+			ast.Inspect(d.Call, func(n ast.Node) bool {
+				if call, ok := n.(*ast.CallExpr); ok {
+					if id, ok := call.Fun.(*ast.Ident); ok && id.Name == "recover" {
+						found = true
+					}
+				}
+
+				return !found
+			})
+		}
+
+		return true
+	})
+
+	return found
+}
@@ -0,0 +1,449 @@
+// Package btreemap is an implementation of an ordered map, loosely
+// modeled on Rust's `BTreeMap`. Entries are kept sorted by key in a
+// B-tree, which supports ordered iteration, range scans, and nearest-key
+// lookups that Go's builtin map cannot.
+package btreemap
+
+import (
+	"github.com/jwhittle933/rs.go/cmp"
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/tuple"
+)
+
+// degree is the minimum degree of the tree: every node but the root
+// holds between degree-1 and 2*degree-1 entries.
+const degree = 16
+
+type entry[K any, V any] struct {
+	key   K
+	value V
+}
+
+type node[K any, V any] struct {
+	entries  []entry[K, V]
+	children []*node[K, V]
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+func (n *node[K, V]) full() bool {
+	return len(n.entries) == 2*degree-1
+}
+
+// Map is an ordered map from `K` to `V`. Create one with `New`,
+// `NewOrd`, or `NewFunc`; the zero value has no ordering and must not
+// be used. A Map is not safe for concurrent use.
+type Map[K any, V any] struct {
+	root    *node[K, V]
+	len     int
+	compare func(a, b K) int
+}
+
+// New returns an empty Map ordered by the natural order of `K`.
+func New[K cmp.Ordered, V any]() *Map[K, V] {
+	return NewFunc[K, V](func(a, b K) int { return cmp.Compare(a, b).Int() })
+}
+
+// NewOrd returns an empty Map ordered by the `Cmp` method of `K`.
+func NewOrd[K cmp.Ord[K], V any]() *Map[K, V] {
+	return NewFunc[K, V](cmp.Natural[K]())
+}
+
+// NewFunc returns an empty Map ordered by `compare`, which returns a
+// negative number, zero, or a positive number when `a` sorts before,
+// with, or after `b`.
+func NewFunc[K any, V any](compare func(a, b K) int) *Map[K, V] {
+	return &Map[K, V]{root: &node[K, V]{}, compare: compare}
+}
+
+// Len returns the number of entries.
+func (m *Map[K, V]) Len() int {
+	return m.len
+}
+
+// IsEmpty reports whether the Map has no entries.
+func (m *Map[K, V]) IsEmpty() bool {
+	return m.len == 0
+}
+
+// search returns the index of the first entry in `n` whose key is
+// not less than `k`, and whether that entry's key equals `k`.
+func (m *Map[K, V]) search(n *node[K, V], k K) (int, bool) {
+	lo, hi := 0, len(n.entries)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if m.compare(n.entries[mid].key, k) < 0 {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+
+	return lo, lo < len(n.entries) && m.compare(n.entries[lo].key, k) == 0
+}
+
+// Get returns the value for `k`, or None if there is none.
+func (m *Map[K, V]) Get(k K) option.Option[V] {
+	for n := m.root; ; {
+		i, found := m.search(n, k)
+		if found {
+			return option.Some(n.entries[i].value)
+		}
+
+		if n.leaf() {
+			return option.None[V]()
+		}
+
+		n = n.children[i]
+	}
+}
+
+// ContainsKey reports whether the Map has a value for `k`.
+func (m *Map[K, V]) ContainsKey(k K) bool {
+	return m.Get(k).IsSome()
+}
+
+// Insert sets the value for `k` to `v` and returns the value it
+// replaced, or None if `k` was not present.
+func (m *Map[K, V]) Insert(k K, v V) option.Option[V] {
+	if m.root.full() {
+		old := m.root
+		m.root = &node[K, V]{children: []*node[K, V]{old}}
+		m.split(m.root, 0)
+	}
+
+	prev := m.insert(m.root, k, v)
+	if prev.IsNone() {
+		m.len++
+	}
+
+	return prev
+}
+
+func (m *Map[K, V]) insert(n *node[K, V], k K, v V) option.Option[V] {
+	for {
+		i, found := m.search(n, k)
+		if found {
+			prev := n.entries[i].value
+			n.entries[i].value = v
+			return option.Some(prev)
+		}
+
+		if n.leaf() {
+			n.entries = insertAt(n.entries, i, entry[K, V]{key: k, value: v})
+			return option.None[V]()
+		}
+
+		if n.children[i].full() {
+			m.split(n, i)
+			switch c := m.compare(k, n.entries[i].key); {
+			case c == 0:
+				prev := n.entries[i].value
+				n.entries[i].value = v
+				return option.Some(prev)
+			case c > 0:
+				i++
+			}
+		}
+
+		n = n.children[i]
+	}
+}
+
+// split moves the median entry of the full child `n.children[i]` up
+// into `n`, and the entries after it into a new right sibling.
+func (m *Map[K, V]) split(n *node[K, V], i int) {
+	child := n.children[i]
+	median := child.entries[degree-1]
+
+	right := &node[K, V]{entries: append([]entry[K, V](nil), child.entries[degree:]...)}
+	if !child.leaf() {
+		right.children = append([]*node[K, V](nil), child.children[degree:]...)
+		clear(child.children[degree:])
+		child.children = child.children[:degree]
+	}
+
+	clear(child.entries[degree-1:])
+	child.entries = child.entries[:degree-1]
+
+	n.entries = insertAt(n.entries, i, median)
+	n.children = insertAt(n.children, i+1, right)
+}
+
+// Remove deletes the value for `k` and returns it, or None if `k`
+// was not present.
+func (m *Map[K, V]) Remove(k K) option.Option[V] {
+	removed := m.remove(m.root, k)
+	if len(m.root.entries) == 0 && !m.root.leaf() {
+		m.root = m.root.children[0]
+	}
+
+	if removed.IsSome() {
+		m.len--
+	}
+
+	return removed
+}
+
+func (m *Map[K, V]) remove(n *node[K, V], k K) option.Option[V] {
+	i, found := m.search(n, k)
+	if n.leaf() {
+		if !found {
+			return option.None[V]()
+		}
+
+		removed := n.entries[i].value
+		n.entries = removeAt(n.entries, i)
+		return option.Some(removed)
+	}
+
+	if found {
+		removed := n.entries[i].value
+		switch {
+		case len(n.children[i].entries) >= degree:
+			pred := maxEntry(n.children[i])
+			n.entries[i] = pred
+			m.remove(n.children[i], pred.key)
+		case len(n.children[i+1].entries) >= degree:
+			succ := minEntry(n.children[i+1])
+			n.entries[i] = succ
+			m.remove(n.children[i+1], succ.key)
+		default:
+			m.merge(n, i)
+			m.remove(n.children[i], k)
+		}
+
+		return option.Some(removed)
+	}
+
+	if len(n.children[i].entries) < degree {
+		i = m.fill(n, i)
+	}
+
+	return m.remove(n.children[i], k)
+}
+
+// fill gives the child `n.children[i]` at least `degree` entries,
+// borrowing from a sibling or merging with one, and returns the index
+// of the child that now covers the same keys.
+func (m *Map[K, V]) fill(n *node[K, V], i int) int {
+	switch {
+	case i > 0 && len(n.children[i-1].entries) >= degree:
+		child, left := n.children[i], n.children[i-1]
+		child.entries = insertAt(child.entries, 0, n.entries[i-1])
+		n.entries[i-1] = left.entries[len(left.entries)-1]
+		left.entries = removeAt(left.entries, len(left.entries)-1)
+		if !left.leaf() {
+			child.children = insertAt(child.children, 0, left.children[len(left.children)-1])
+			left.children = removeAt(left.children, len(left.children)-1)
+		}
+
+		return i
+	case i < len(n.children)-1 && len(n.children[i+1].entries) >= degree:
+		child, right := n.children[i], n.children[i+1]
+		child.entries = append(child.entries, n.entries[i])
+		n.entries[i] = right.entries[0]
+		right.entries = removeAt(right.entries, 0)
+		if !right.leaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = removeAt(right.children, 0)
+		}
+
+		return i
+	case i < len(n.children)-1:
+		m.merge(n, i)
+		return i
+	default:
+		m.merge(n, i-1)
+		return i - 1
+	}
+}
+
+// merge folds the entry `n.entries[i]` and the child to its right
+// into the child to its left.
+func (m *Map[K, V]) merge(n *node[K, V], i int) {
+	left, right := n.children[i], n.children[i+1]
+	left.entries = append(append(left.entries, n.entries[i]), right.entries...)
+	left.children = append(left.children, right.children...)
+
+	n.entries = removeAt(n.entries, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+// insertAt inserts `v` into `s` at `i`.
+func insertAt[T any](s []T, i int, v T) []T {
+	var zero T
+	s = append(s, zero)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+// removeAt removes `s[i]`, zeroing the vacated slot so it does not
+// keep its contents alive.
+func removeAt[T any](s []T, i int) []T {
+	var zero T
+	copy(s[i:], s[i+1:])
+	s[len(s)-1] = zero
+	return s[:len(s)-1]
+}
+
+func minEntry[K any, V any](n *node[K, V]) entry[K, V] {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+
+	return n.entries[0]
+}
+
+func maxEntry[K any, V any](n *node[K, V]) entry[K, V] {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+
+	return n.entries[len(n.entries)-1]
+}
+
+func pair[K any, V any](e entry[K, V]) tuple.Pair[K, V] {
+	return tuple.NewPair(e.key, e.value)
+}
+
+// FirstEntry returns the entry with the smallest key, or None if the
+// Map is empty.
+func (m *Map[K, V]) FirstEntry() option.Option[tuple.Pair[K, V]] {
+	if m.len == 0 {
+		return option.None[tuple.Pair[K, V]]()
+	}
+
+	return option.Some(pair(minEntry(m.root)))
+}
+
+// LastEntry returns the entry with the largest key, or None if the
+// Map is empty.
+func (m *Map[K, V]) LastEntry() option.Option[tuple.Pair[K, V]] {
+	if m.len == 0 {
+		return option.None[tuple.Pair[K, V]]()
+	}
+
+	return option.Some(pair(maxEntry(m.root)))
+}
+
+// Ceiling returns the entry with the smallest key not less than `k`,
+// or None if there is none.
+func (m *Map[K, V]) Ceiling(k K) option.Option[tuple.Pair[K, V]] {
+	best := option.None[tuple.Pair[K, V]]()
+	for n := m.root; ; {
+		i, found := m.search(n, k)
+		if found {
+			return option.Some(pair(n.entries[i]))
+		}
+
+		if i < len(n.entries) {
+			best = option.Some(pair(n.entries[i]))
+		}
+
+		if n.leaf() {
+			return best
+		}
+
+		n = n.children[i]
+	}
+}
+
+// Floor returns the entry with the largest key not greater than `k`,
+// or None if there is none.
+func (m *Map[K, V]) Floor(k K) option.Option[tuple.Pair[K, V]] {
+	best := option.None[tuple.Pair[K, V]]()
+	for n := m.root; ; {
+		i, found := m.search(n, k)
+		if found {
+			return option.Some(pair(n.entries[i]))
+		}
+
+		if i > 0 {
+			best = option.Some(pair(n.entries[i-1]))
+		}
+
+		if n.leaf() {
+			return best
+		}
+
+		n = n.children[i]
+	}
+}
+
+type frame[K any, V any] struct {
+	n *node[K, V]
+	i int
+}
+
+// Iter returns an Iterator over the entries in ascending key order.
+// The Map must not be modified while the Iterator is in use.
+func (m *Map[K, V]) Iter() iter.Iterator[tuple.Pair[K, V]] {
+	var stack []frame[K, V]
+	for n := m.root; ; n = n.children[0] {
+		stack = append(stack, frame[K, V]{n: n})
+		if n.leaf() {
+			break
+		}
+	}
+
+	return m.walk(stack, option.None[K]())
+}
+
+// Range returns an Iterator over the entries with keys in `[lo, hi)`,
+// in ascending key order. The Map must not be modified while the
+// Iterator is in use.
+func (m *Map[K, V]) Range(lo, hi K) iter.Iterator[tuple.Pair[K, V]] {
+	var stack []frame[K, V]
+	for n := m.root; ; {
+		i, _ := m.search(n, lo)
+		stack = append(stack, frame[K, V]{n: n, i: i})
+		if n.leaf() {
+			break
+		}
+
+		n = n.children[i]
+	}
+
+	return m.walk(stack, option.Some(hi))
+}
+
+// walk performs an in-order traversal from the position described by
+// `stack`, stopping before any key not less than `hi`, if given. Each
+// frame records a node and the index of its next entry to yield; the
+// frames above it cover the subtree to that entry's left.
+func (m *Map[K, V]) walk(stack []frame[K, V], hi option.Option[K]) iter.Iterator[tuple.Pair[K, V]] {
+	return iter.Func[tuple.Pair[K, V]](func() option.Option[tuple.Pair[K, V]] {
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.i >= len(top.n.entries) {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+
+			e := top.n.entries[top.i]
+			if hi.IsSome() && m.compare(e.key, hi.Unwrap()) >= 0 {
+				stack = nil
+				break
+			}
+
+			top.i++
+			if !top.n.leaf() {
+				for n := top.n.children[top.i]; ; n = n.children[0] {
+					stack = append(stack, frame[K, V]{n: n})
+					if n.leaf() {
+						break
+					}
+				}
+			}
+
+			return option.Some(pair(e))
+		}
+
+		return option.None[tuple.Pair[K, V]]()
+	})
+}
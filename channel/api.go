@@ -0,0 +1,186 @@
+// Package channel is an implementation of multi-producer,
+// single-consumer channels, loosely modeled on Rust's `std::sync::mpsc`.
+// Sending on a closed channel returns an error `Result` instead of
+// panicking, and receiving from a closed channel returns `None`.
+package channel
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// ErrClosed is reported when sending on a closed channel.
+var ErrClosed = errors.New("channel: send on closed channel")
+
+// SendError reports why a value could not be sent. It wraps either
+// `ErrClosed` or the error of a cancelled context.
+type SendError struct {
+	Err error
+}
+
+func (e SendError) Error() string {
+	return e.Err.Error()
+}
+
+func (e SendError) Unwrap() error {
+	return e.Err
+}
+
+// shared is the state behind both halves. Close happens in two steps
+// so that nothing is accepted once receivers can see the channel is
+// closed: `closing` makes blocked senders give up, and `done` is closed
+// only after every send in progress has finished, under `mu`.
+type shared[T any] struct {
+	ch      chan T
+	closing chan struct{}
+	done    chan struct{}
+	mu      sync.RWMutex
+	once    sync.Once
+}
+
+func (s *shared[T]) closed() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// stopping reports whether `Close` has begun, after which senders
+// accept nothing more.
+func (s *shared[T]) stopping() bool {
+	select {
+	case <-s.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// Sender is the sending half of a channel. Senders are cheap to
+// copy and may be shared across goroutines.
+type Sender[T any] struct {
+	s *shared[T]
+}
+
+// Receiver is the receiving half of a channel.
+type Receiver[T any] struct {
+	s *shared[T]
+}
+
+// New returns a connected Sender and Receiver. A `buffer` of 0
+// creates an unbuffered (rendezvous) channel.
+func New[T any](buffer int) (Sender[T], Receiver[T]) {
+	s := &shared[T]{
+		ch:      make(chan T, buffer),
+		closing: make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	return Sender[T]{s: s}, Receiver[T]{s: s}
+}
+
+// Send delivers `v`, blocking until there is room for it. If the
+// channel is closed, Send returns an error Result wrapping `ErrClosed`.
+func (tx Sender[T]) Send(v T) result.Result[struct{}, SendError] {
+	return tx.SendCtx(context.Background(), v)
+}
+
+// SendCtx is like `Send`, but gives up when `ctx` is done, returning
+// an error Result wrapping `ctx.Err()`.
+func (tx Sender[T]) SendCtx(ctx context.Context, v T) result.Result[struct{}, SendError] {
+	tx.s.mu.RLock()
+	defer tx.s.mu.RUnlock()
+
+	if tx.s.stopping() {
+		return result.Err[struct{}](SendError{Err: ErrClosed})
+	}
+
+	select {
+	case tx.s.ch <- v:
+		return result.OkOf[struct{}, SendError](struct{}{})
+	case <-tx.s.closing:
+		return result.Err[struct{}](SendError{Err: ErrClosed})
+	case <-ctx.Done():
+		return result.Err[struct{}](SendError{Err: ctx.Err()})
+	}
+}
+
+// TrySend delivers `v` only if it can be done without blocking.
+// The returned bool reports whether the value was sent.
+func (tx Sender[T]) TrySend(v T) bool {
+	tx.s.mu.RLock()
+	defer tx.s.mu.RUnlock()
+
+	if tx.s.stopping() {
+		return false
+	}
+
+	select {
+	case tx.s.ch <- v:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close closes the channel for every Sender. Values already
+// buffered can still be received. Close is safe to call more than once.
+func (tx Sender[T]) Close() {
+	tx.s.once.Do(func() {
+		close(tx.s.closing)
+		tx.s.mu.Lock()
+		close(tx.s.done)
+		tx.s.mu.Unlock()
+	})
+}
+
+// Recv blocks until a value is available and returns it as `Some`.
+// Once the channel is closed and drained, Recv returns `None`.
+func (rx Receiver[T]) Recv() option.Option[T] {
+	select {
+	case v := <-rx.s.ch:
+		return option.Some(v)
+	case <-rx.s.done:
+		return rx.TryRecv()
+	}
+}
+
+// RecvCtx is like `Recv`, but gives up when `ctx` is done. The error
+// Result holds `ErrClosed` once the channel is closed and drained,
+// or `ctx.Err()` on cancellation.
+func (rx Receiver[T]) RecvCtx(ctx context.Context) result.Result[T, error] {
+	select {
+	case v := <-rx.s.ch:
+		return result.Ok(v)
+	case <-rx.s.done:
+		if v := rx.TryRecv(); v.IsSome() {
+			return result.Ok(v.Unwrap())
+		}
+
+		return result.Err[T](ErrClosed)
+	case <-ctx.Done():
+		return result.Err[T](ctx.Err())
+	}
+}
+
+// TryRecv returns a buffered value without blocking, or `None`
+// if none is ready.
+func (rx Receiver[T]) TryRecv() option.Option[T] {
+	select {
+	case v := <-rx.s.ch:
+		return option.Some(v)
+	default:
+		return option.None[T]()
+	}
+}
+
+// IsClosed reports whether the channel has been closed.
+func (rx Receiver[T]) IsClosed() bool {
+	return rx.s.closed()
+}
@@ -0,0 +1,177 @@
+// Package clone is an implementation of explicit value duplication,
+// loosely modeled on Rust's `Clone` trait. Types that know how to copy
+// themselves implement `Cloner`; everything else can be deep-copied
+// with reflection.
+package clone
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Cloner is implemented by types that can produce an independent
+// copy of themselves.
+type Cloner[T any] interface {
+	Clone() T
+}
+
+// UnsupportedError reports a value that cannot be deep-copied, such
+// as a channel or a function.
+type UnsupportedError struct {
+	Type reflect.Type
+}
+
+func (e *UnsupportedError) Error() string {
+	return fmt.Sprintf("clone: cannot deep-copy value of type %s", e.Type)
+}
+
+// Of returns a copy of `v`, using its `Clone` method if `T`
+// implements `Cloner[T]`, and `Deep` otherwise.
+func Of[T any](v T) T {
+	if c, ok := any(v).(Cloner[T]); ok {
+		return c.Clone()
+	}
+
+	return Deep(v)
+}
+
+// Deep returns a deep copy of `v`. Pointers, slices, maps, arrays,
+// interfaces, and structs (including unexported fields) are copied
+// recursively, and shared or cyclic pointers stay shared in the copy.
+// Nested values with a `Clone() T` method of their own type are copied
+// with it. Channels, functions, and unsafe pointers cannot be copied
+// and are shared with the original; use `TryDeep` to reject them.
+func Deep[T any](v T) T {
+	c := cloner{seen: map[seenKey]reflect.Value{}}
+	return root(&c, v)
+}
+
+// TryDeep is like `Deep`, but returns an `*UnsupportedError` instead
+// of sharing a channel, function, or unsafe pointer.
+func TryDeep[T any](v T) result.Result[T, error] {
+	c := cloner{seen: map[seenKey]reflect.Value{}, strict: true}
+	out := root(&c, v)
+	if c.err != nil {
+		return result.Err[T](c.err)
+	}
+
+	return result.Ok(out)
+}
+
+type seenKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+type cloner struct {
+	seen   map[seenKey]reflect.Value
+	strict bool
+	err    error
+}
+
+func root[T any](c *cloner, v T) T {
+	var out T
+	reflect.ValueOf(&out).Elem().Set(c.copy(reflect.ValueOf(&v).Elem(), false))
+	return out
+}
+
+// copy returns a deep copy of `v`. `useMethod` allows a `Clone`
+// method on `v` to be used; it is false for the root so that a Clone
+// method implemented with Deep does not recurse forever.
+func (c *cloner) copy(v reflect.Value, useMethod bool) reflect.Value {
+	if !v.IsValid() {
+		return v
+	}
+
+	if useMethod {
+		if m := v.MethodByName("Clone"); m.IsValid() && m.Type().NumIn() == 0 &&
+			m.Type().NumOut() == 1 && m.Type().Out(0) == v.Type() {
+			return m.Call(nil)[0]
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+
+		key := seenKey{ptr: v.Pointer(), typ: v.Type()}
+		if out, ok := c.seen[key]; ok {
+			return out
+		}
+
+		out := reflect.New(v.Type().Elem())
+		c.seen[key] = out
+		out.Elem().Set(c.copy(v.Elem(), true))
+		return out
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		out := reflect.New(v.Type()).Elem()
+		out.Set(c.copy(v.Elem(), true))
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(c.copy(v.Index(i), true))
+		}
+
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(c.copy(iter.Key(), true), c.copy(iter.Value(), true))
+		}
+
+		return out
+	case reflect.Array:
+		out := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(c.copy(v.Index(i), true))
+		}
+
+		return out
+	case reflect.Struct:
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := 0; i < out.NumField(); i++ {
+			f := settable(out.Field(i))
+			f.Set(c.copy(f, true))
+		}
+
+		return out
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if c.strict && c.err == nil && !v.IsNil() {
+			c.err = &UnsupportedError{Type: v.Type()}
+		}
+
+		return v
+	default:
+		return v
+	}
+}
+
+// settable returns a view of the addressable field `f` that can be
+// read and written even if the field is unexported.
+func settable(f reflect.Value) reflect.Value {
+	if f.CanSet() {
+		return f
+	}
+
+	return reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
+}
@@ -0,0 +1,549 @@
+// Command rsgen generates non-generic, specialized copies of `Option`
+// and `Result` for named types. The generated types keep the method
+// names of the generic API, so switching between them is a change of
+// type name only. They suit codebases that must build with Go versions
+// before generics, and hot paths where generic dictionaries show up in
+// profiles.
+//
+// Typical use is from a `go:generate` directive:
+//
+//	//go:generate go run github.com/jwhittle933/rs.go/cmd/rsgen -type User,Order
+//
+// For each type `Foo` this emits `OptionFoo`, with `SomeFoo` and
+// `NoneFoo`, and `ResultFooErr`, with `OkFooErr`, `ErrFooErr`, and
+// `MatchFooErr`. The error type defaults to `error` and may be set with
+// -err; a type other than `error` is named by its capitalized name, as
+// in `ResultFooMyErr`. An `Option` for the error type is also emitted,
+// since `Result.Err` returns one.
+//
+// Composite types are named after their parts: `[]byte` becomes
+// `ByteSlice`, `*Foo` becomes `PtrFoo`, `map[string]int` becomes
+// `StringIntMap`, and `pkg.Set[int]` becomes `SetInt`. Function, struct,
+// and interface types other than `any` have no such name and are
+// rejected; give them a named type first.
+//
+// Each run emits an Option for every type it is given, and for the
+// error type, so two runs for the same package that share a type
+// would declare its Option twice: `-type User -err error` and `-type
+// User -err MyErr` both declare `OptionUser`. Pass the shared types to
+// -skip-options in all but one of the runs, as in `-skip-options User`.
+//
+// Unlike the generic types, generated code panics directly and does
+// not call the handler set with `rs.SetUnwrapHandler`, and
+// `UnwrapOrDefault` returns the zero value. Generated Results have the
+// methods of `result.Result` that chain and extract values; the error
+// interop (`AsError`, `Is`, `As`), hashing, JSON, logging, and formatting
+// methods are not generated.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+type typeInfo struct {
+	Type string // Go type, as written in source
+	Name string // identifier suffix derived from Type
+}
+
+type resultInfo struct {
+	T, E       typeInfo
+	Name       string // suffix for the Result type: T.Name + E suffix
+	ErrIsError bool
+}
+
+type file struct {
+	Package string
+	Command string
+	Options []typeInfo
+	Results []resultInfo
+}
+
+func main() {
+	var (
+		types   = flag.String("type", "", "comma-separated list of type names; required")
+		errType = flag.String("err", "error", "error type of the generated Results")
+		pkg     = flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+		output  = flag.String("output", "", "output file; default <first type>_rs.go")
+		skip    = flag.String("skip-options", "", "comma-separated list of types whose Options another run emits")
+	)
+	flag.Parse()
+
+	if *types == "" || *pkg == "" {
+		fmt.Fprintln(os.Stderr, "rsgen: -type and -package (or $GOPACKAGE) are required")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	names := strings.Split(*types, ",")
+	var skipped []string
+	if *skip != "" {
+		skipped = strings.Split(*skip, ",")
+	}
+
+	src, err := generate(*pkg, names, *errType, skipped)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "rsgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	out := *output
+	if out == "" {
+		t, _ := info(strings.TrimSpace(names[0]))
+		out = strings.ToLower(t.Name) + "_rs.go"
+	}
+
+	if err := os.WriteFile(out, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "rsgen: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func generate(pkg string, names []string, errType string, skipped []string) ([]byte, error) {
+	f := file{
+		Package: pkg,
+		Command: "rsgen " + strings.Join(os.Args[1:], " "),
+	}
+
+	e, err := info(errType)
+	if err != nil {
+		return nil, err
+	}
+
+	errSuffix := e.Name
+	if errType == "error" {
+		errSuffix = "Err"
+	}
+
+	seen := map[string]bool{}
+	for _, n := range skipped {
+		seen[strings.TrimSpace(n)] = true
+	}
+
+	addOption := func(t typeInfo) {
+		if !seen[t.Type] {
+			seen[t.Type] = true
+			f.Options = append(f.Options, t)
+		}
+	}
+
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		if n == "" {
+			continue
+		}
+
+		t, err := info(n)
+		if err != nil {
+			return nil, err
+		}
+
+		addOption(t)
+		f.Results = append(f.Results, resultInfo{
+			T:          t,
+			E:          e,
+			Name:       t.Name + errSuffix,
+			ErrIsError: errType == "error",
+		})
+	}
+	addOption(e)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, f); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+// info derives an identifier suffix from a type: `Foo` and `pkg.Foo`
+// become `Foo`, `int` becomes `Int`, and composite types are named
+// after their parts, as described in the package doc.
+func info(t string) (typeInfo, error) {
+	expr, err := parser.ParseExpr(t)
+	if err != nil {
+		return typeInfo{}, fmt.Errorf("invalid type %q: %w", t, err)
+	}
+
+	name, err := typeName(expr)
+	if err != nil {
+		return typeInfo{}, fmt.Errorf("type %q: %w", t, err)
+	}
+
+	return typeInfo{Type: t, Name: name}, nil
+}
+
+func typeName(expr ast.Expr) (string, error) {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		return capitalize(x.Name), nil
+	case *ast.SelectorExpr:
+		return capitalize(x.Sel.Name), nil
+	case *ast.ParenExpr:
+		return typeName(x.X)
+	case *ast.StarExpr:
+		return join("Ptr", x.X)
+	case *ast.ArrayType:
+		elem, err := typeName(x.Elt)
+		if err != nil {
+			return "", err
+		}
+
+		if x.Len == nil {
+			return elem + "Slice", nil
+		}
+
+		lit, ok := x.Len.(*ast.BasicLit)
+		if !ok {
+			return "", fmt.Errorf("array length must be a literal")
+		}
+
+		return elem + "Array" + lit.Value, nil
+	case *ast.MapType:
+		return join("", x.Key, x.Value, "Map")
+	case *ast.ChanType:
+		return join("", x.Value, "Chan")
+	case *ast.IndexExpr:
+		return join("", x.X, x.Index)
+	case *ast.IndexListExpr:
+		parts := []any{x.X}
+		for _, idx := range x.Indices {
+			parts = append(parts, idx)
+		}
+
+		return join("", parts...)
+	case *ast.InterfaceType:
+		if len(x.Methods.List) == 0 {
+			return "Any", nil
+		}
+	}
+
+	return "", fmt.Errorf("has no identifier form; declare a named type for it")
+}
+
+// join concatenates `prefix` and the names of `parts`, which are
+// expressions or literal strings.
+func join(prefix string, parts ...any) (string, error) {
+	name := prefix
+	for _, p := range parts {
+		switch p := p.(type) {
+		case string:
+			name += p
+		case ast.Expr:
+			n, err := typeName(p)
+			if err != nil {
+				return "", err
+			}
+
+			name += n
+		}
+	}
+
+	return name, nil
+}
+
+func capitalize(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+var tmpl = template.Must(template.New("rs").Parse(`// Code generated by {{.Command}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"fmt"
+	"reflect"
+)
+{{range .Options}}
+// Option{{.Name}} is a specialized ` + "`option.Option[{{.Type}}]`" + `.
+type Option{{.Name}} struct {
+	value {{.Type}}
+	some  bool
+}
+
+func (o Option{{.Name}}) And(other Option{{.Name}}) Option{{.Name}} {
+	if o.IsSome() {
+		return o
+	}
+
+	return other
+}
+
+func (o Option{{.Name}}) AndThen(fn func(data {{.Type}}) Option{{.Name}}) Option{{.Name}} {
+	if o.IsSome() {
+		return fn(o.value)
+	}
+
+	return o
+}
+
+func (o Option{{.Name}}) IsSome() bool {
+	return o.some
+}
+
+func (o Option{{.Name}}) IsNone() bool {
+	return !o.some
+}
+
+func (o Option{{.Name}}) Expect(msg string) {{.Type}} {
+	if o.IsNone() {
+		panic(msg)
+	}
+
+	return o.value
+}
+
+func (o Option{{.Name}}) Unwrap() {{.Type}} {
+	return o.Expect("unwrapped a none")
+}
+
+func (o Option{{.Name}}) UnwrapOrDefault() {{.Type}} {
+	if o.IsSome() {
+		return o.value
+	}
+
+	var zero {{.Type}}
+	return zero
+}
+
+func Some{{.Name}}(data {{.Type}}) Option{{.Name}} {
+	return Option{{.Name}}{value: data, some: true}
+}
+
+func None{{.Name}}() Option{{.Name}} {
+	return Option{{.Name}}{}
+}
+{{end}}{{range .Results}}{{$r := printf "Result%s" .Name}}
+// {{$r}} is a specialized ` + "`result.Result[{{.T.Type}}, {{.E.Type}}]`" + `.
+type {{$r}} struct {
+	ok    {{.T.Type}}
+	err   {{.E.Type}}
+	state uint8 // 0 for neither, 1 for ok, 2 for error
+}
+
+func (r {{$r}}) And(res {{$r}}) {{$r}} {
+	if r.IsOk() {
+		return res
+	}
+
+	return r
+}
+
+func (r {{$r}}) AndThen(fn func(data {{.T.Type}}) {{$r}}) {{$r}} {
+	if r.IsOk() {
+		return fn(r.ok)
+	}
+
+	return r
+}
+
+func (r {{$r}}) Or(res {{$r}}) {{$r}} {
+	if r.IsOk() {
+		return r
+	}
+
+	return res
+}
+
+func (r {{$r}}) OrElse(fn func(e {{.E.Type}}) {{$r}}) {{$r}} {
+	if r.IsErr() {
+		return fn(r.err)
+	}
+
+	return r
+}
+
+func (r {{$r}}) Contains(data {{.T.Type}}) bool {
+	return r.IsOk() && reflect.DeepEqual(r.ok, data)
+}
+
+func (r {{$r}}) ContainsFunc(pred func(data {{.T.Type}}) bool) bool {
+	return r.IsOk() && pred(r.ok)
+}
+
+func (r {{$r}}) ContainsErr(e {{.E.Type}}) bool {
+	return r.IsErr() && reflect.DeepEqual(r.err, e)
+}
+
+func (r {{$r}}) ContainsErrFunc(pred func(e {{.E.Type}}) bool) bool {
+	return r.IsErr() && pred(r.err)
+}
+
+func (r {{$r}}) Map(fn func(data {{.T.Type}}) {{.T.Type}}) {{$r}} {
+	if r.IsOk() {
+		op := fn(r.ok)
+		return {{$r}}{ok: op, state: 1}
+	}
+
+	return r
+}
+
+func (r {{$r}}) MapErr(fn func(e {{.E.Type}}) {{.E.Type}}) {{$r}} {
+	if r.IsErr() {
+		op := fn(r.err)
+		return {{$r}}{err: op, state: 2}
+	}
+
+	return r
+}
+
+func (r {{$r}}) MapOr(def {{.T.Type}}, fn func(data {{.T.Type}}) {{.T.Type}}) {{.T.Type}} {
+	if r.IsOk() {
+		return fn(r.ok)
+	}
+
+	return def
+}
+
+func (r {{$r}}) MapOrElse(defFn func(e {{.E.Type}}) {{.T.Type}}, fn func(data {{.T.Type}}) {{.T.Type}}) {{.T.Type}} {
+	if r.IsOk() {
+		return fn(r.ok)
+	}
+
+	return defFn(r.err)
+}
+
+func (r {{$r}}) Inspect(fn func(data {{.T.Type}})) {{$r}} {
+	if r.IsOk() {
+		fn(r.ok)
+	}
+
+	return r
+}
+
+func (r {{$r}}) InspectErr(fn func(e {{.E.Type}})) {{$r}} {
+	if r.IsErr() {
+		fn(r.err)
+	}
+
+	return r
+}
+
+func (r {{$r}}) Ok() Option{{.T.Name}} {
+	if r.IsOk() {
+		return Some{{.T.Name}}(r.ok)
+	}
+
+	return None{{.T.Name}}()
+}
+
+func (r {{$r}}) IsOk() bool {
+	return r.state == 1
+}
+
+func (r {{$r}}) IsOkAnd(fn func(data {{.T.Type}}) bool) bool {
+	return r.IsOk() && fn(r.ok)
+}
+
+func (r {{$r}}) IsErr() bool {
+	return r.state == 2
+}
+
+func (r {{$r}}) Err() Option{{.E.Name}} {
+	if r.IsErr() {
+		return Some{{.E.Name}}(r.err)
+	}
+
+	return None{{.E.Name}}()
+}
+
+func (r {{$r}}) Expect(msg string) {{.T.Type}} {
+	if !r.IsOk() {
+		panic(msg)
+	}
+
+	return r.ok
+}
+
+func (r {{$r}}) ExpectErr(msg string) {{.E.Type}} {
+	if !r.IsErr() {
+		panic(msg)
+	}
+
+	return r.err
+}
+
+func (r {{$r}}) Expectf(format string, args ...interface{}) {{.T.Type}} {
+	if !r.IsOk() {
+		panic(fmt.Sprintf(format, args...))
+	}
+
+	return r.ok
+}
+
+func (r {{$r}}) ExpectErrf(format string, args ...interface{}) {{.E.Type}} {
+	if !r.IsErr() {
+		panic(fmt.Sprintf(format, args...))
+	}
+
+	return r.err
+}
+
+func (r {{$r}}) Unwrap() {{.T.Type}} {
+	return r.Expect("called Unwrap an on an error")
+}
+
+func (r {{$r}}) UnwrapErr() {{.E.Type}} {
+	return r.ExpectErr("called UnwrapErr an ok")
+}
+
+func (r {{$r}}) UnwrapOrDefault() {{.T.Type}} {
+	if r.IsOk() {
+		return r.ok
+	}
+
+	var zero {{.T.Type}}
+	return zero
+}
+
+func (r {{$r}}) UnwrapOr(def {{.T.Type}}) {{.T.Type}} {
+	if r.IsOk() {
+		return r.ok
+	}
+
+	return def
+}
+
+func (r {{$r}}) UnwrapOrElse(fn func(e {{.E.Type}}) {{.T.Type}}) {{.T.Type}} {
+	if r.IsOk() {
+		return r.ok
+	}
+
+	return fn(r.err)
+}
+
+func (r {{$r}}) Tuple() ({{.T.Type}}, {{.E.Type}}) {
+	return r.ok, r.err
+}
+
+func Ok{{.Name}}(data {{.T.Type}}) {{$r}} {
+	return {{$r}}{ok: data, state: 1}
+}
+
+func Err{{.Name}}(e {{.E.Type}}) {{$r}} {
+	return {{$r}}{err: e, state: 2}
+}
+{{if .ErrIsError}}
+func Match{{.Name}}(data {{.T.Type}}, e error) {{$r}} {
+	if e != nil {
+		return Err{{.Name}}(e)
+	}
+
+	return Ok{{.Name}}(data)
+}
+{{end}}{{end}}`))
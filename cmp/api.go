@@ -0,0 +1,118 @@
+// Package cmp is an implementation of chainable comparisons,
+// loosely modeled on Rust's `std::cmp`. It complements, rather than
+// replaces, the standard library's `cmp` package.
+package cmp
+
+import (
+	stdcmp "cmp"
+)
+
+// Ordered is a constraint permitting any type that supports the
+// `<`, `<=`, `>=`, and `>` operators. It is the standard library's
+// `cmp.Ordered`, re-exported so callers need only one import.
+type Ordered = stdcmp.Ordered
+
+// Ordering is the result of comparing two values.
+type Ordering int
+
+const (
+	Less    Ordering = -1
+	Equal   Ordering = 0
+	Greater Ordering = 1
+)
+
+// Compare returns the Ordering of `a` relative to `b`. Like the
+// standard library, a NaN is considered less than any non-NaN value
+// and equal to another NaN.
+func Compare[T Ordered](a, b T) Ordering {
+	return FromInt(stdcmp.Compare(a, b))
+}
+
+// FromInt converts the int returned by a conventional comparator,
+// such as `strings.Compare`, into an Ordering.
+func FromInt(n int) Ordering {
+	switch {
+	case n < 0:
+		return Less
+	case n > 0:
+		return Greater
+	default:
+		return Equal
+	}
+}
+
+// Then returns `o` unless it is Equal, in which case it returns
+// `other`. Chain calls to compare by several keys in priority order:
+//
+//	cmp.Compare(a.Last, b.Last).Then(cmp.Compare(a.First, b.First))
+func (o Ordering) Then(other Ordering) Ordering {
+	if o != Equal {
+		return o
+	}
+
+	return other
+}
+
+// ThenWith is like `Then`, but only calls `fn` to compute the
+// tie-breaker when `o` is Equal.
+func (o Ordering) ThenWith(fn func() Ordering) Ordering {
+	if o != Equal {
+		return o
+	}
+
+	return fn()
+}
+
+// Reverse swaps Less and Greater.
+func (o Ordering) Reverse() Ordering {
+	return -o
+}
+
+// Int returns the Ordering as -1, 0, or 1, the convention used by
+// `sort` and `slices` comparators.
+func (o Ordering) Int() int {
+	return int(o)
+}
+
+// IsEq reports whether `o` is Equal.
+func (o Ordering) IsEq() bool {
+	return o == Equal
+}
+
+// IsNe reports whether `o` is not Equal.
+func (o Ordering) IsNe() bool {
+	return o != Equal
+}
+
+// IsLt reports whether `o` is Less.
+func (o Ordering) IsLt() bool {
+	return o == Less
+}
+
+// IsLe reports whether `o` is Less or Equal.
+func (o Ordering) IsLe() bool {
+	return o != Greater
+}
+
+// IsGt reports whether `o` is Greater.
+func (o Ordering) IsGt() bool {
+	return o == Greater
+}
+
+// IsGe reports whether `o` is Greater or Equal.
+func (o Ordering) IsGe() bool {
+	return o != Less
+}
+
+func (o Ordering) String() string {
+	switch o {
+	case Less:
+		return "Less"
+	case Greater:
+		return "Greater"
+	case Equal:
+		return "Equal"
+	default:
+		return "Ordering(invalid)"
+	}
+}
@@ -0,0 +1,55 @@
+package cmp
+
+// By returns a comparator that orders values by the key `key`
+// extracts from them. The comparator can be passed directly to
+// `slices.SortFunc` and friends.
+func By[T any, K Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return Compare(key(a), key(b)).Int()
+	}
+}
+
+// ByOrd is `By` for keys implementing `Ord`.
+func ByOrd[T any, K Ord[K]](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return key(a).Cmp(key(b)).Int()
+	}
+}
+
+// Natural returns a comparator for types implementing `Ord`.
+func Natural[T Ord[T]]() func(a, b T) int {
+	return func(a, b T) int {
+		return a.Cmp(b).Int()
+	}
+}
+
+// Reversed returns a comparator that orders values in the opposite
+// order of `c`.
+func Reversed[T any](c func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// Chain returns a comparator that tries each of `cs` in turn,
+// returning the first non-zero result. It orders by the first
+// comparator and breaks ties with the rest.
+func Chain[T any](cs ...func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		for _, c := range cs {
+			if n := c(a, b); n != 0 {
+				return n
+			}
+		}
+
+		return 0
+	}
+}
+
+// SortByOrdering adapts a function returning an Ordering into a
+// comparator usable with `slices.SortFunc`.
+func SortByOrdering[T any](fn func(a, b T) Ordering) func(a, b T) int {
+	return func(a, b T) int {
+		return fn(a, b).Int()
+	}
+}
@@ -0,0 +1,82 @@
+package cmp
+
+// Ord is implemented by types with a total order. It lets user types
+// take part in the helpers of this package, and in ordered collections,
+// the way built-in `Ordered` types do.
+type Ord[T any] interface {
+	Cmp(other T) Ordering
+}
+
+// Max returns the larger of `a` and `b`, or `b` if they are equal.
+func Max[T Ordered](a, b T) T {
+	if Compare(a, b).IsGt() {
+		return a
+	}
+
+	return b
+}
+
+// Min returns the smaller of `a` and `b`, or `a` if they are equal.
+func Min[T Ordered](a, b T) T {
+	if Compare(a, b).IsLe() {
+		return a
+	}
+
+	return b
+}
+
+// MinMax returns `a` and `b` in ascending order. If they are equal,
+// they are returned as given.
+func MinMax[T Ordered](a, b T) (T, T) {
+	if Compare(a, b).IsLe() {
+		return a, b
+	}
+
+	return b, a
+}
+
+// Clamp restricts `v` to the interval `[lo, hi]`. It panics if `lo`
+// is greater than `hi`.
+func Clamp[T Ordered](v, lo, hi T) T {
+	if Compare(lo, hi).IsGt() {
+		panic("cmp: Clamp called with lo > hi")
+	}
+
+	return Max(lo, Min(v, hi))
+}
+
+// MaxOrd is `Max` for types implementing `Ord`.
+func MaxOrd[T Ord[T]](a, b T) T {
+	if a.Cmp(b).IsGt() {
+		return a
+	}
+
+	return b
+}
+
+// MinOrd is `Min` for types implementing `Ord`.
+func MinOrd[T Ord[T]](a, b T) T {
+	if a.Cmp(b).IsLe() {
+		return a
+	}
+
+	return b
+}
+
+// MinMaxOrd is `MinMax` for types implementing `Ord`.
+func MinMaxOrd[T Ord[T]](a, b T) (T, T) {
+	if a.Cmp(b).IsLe() {
+		return a, b
+	}
+
+	return b, a
+}
+
+// ClampOrd is `Clamp` for types implementing `Ord`.
+func ClampOrd[T Ord[T]](v, lo, hi T) T {
+	if lo.Cmp(hi).IsGt() {
+		panic("cmp: ClampOrd called with lo > hi")
+	}
+
+	return MaxOrd(lo, MinOrd(v, hi))
+}
@@ -0,0 +1,72 @@
+package cmp
+
+import (
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// PartialOrd is implemented by types where some pairs of values
+// cannot be ordered, such as versions from unrelated branches.
+type PartialOrd[T any] interface {
+	PartialCmp(other T) option.Option[Ordering]
+}
+
+// PartialCmp returns the Ordering of `a` relative to `b`, or None if
+// they cannot be ordered. For built-in types that only happens when
+// either value is a floating-point NaN.
+func PartialCmp[T Ordered](a, b T) option.Option[Ordering] {
+	if isNaN(a) || isNaN(b) {
+		return option.None[Ordering]()
+	}
+
+	return option.Some(Compare(a, b))
+}
+
+// PartialMax returns the larger of `a` and `b`, `b` if they are
+// equal, or None if they cannot be ordered.
+func PartialMax[T Ordered](a, b T) option.Option[T] {
+	return PartialMaxFunc(a, b, PartialCmp[T])
+}
+
+// PartialMin returns the smaller of `a` and `b`, `a` if they are
+// equal, or None if they cannot be ordered.
+func PartialMin[T Ordered](a, b T) option.Option[T] {
+	return PartialMinFunc(a, b, PartialCmp[T])
+}
+
+// PartialMaxFunc is `PartialMax` using `cmp` to order the values.
+// Pass a method expression such as `Version.PartialCmp` to use it
+// with a `PartialOrd` type.
+func PartialMaxFunc[T any](a, b T, cmp func(a, b T) option.Option[Ordering]) option.Option[T] {
+	o := cmp(a, b)
+	if o.IsNone() {
+		return option.None[T]()
+	}
+
+	if o.Unwrap().IsGt() {
+		return option.Some(a)
+	}
+
+	return option.Some(b)
+}
+
+// PartialMinFunc is `PartialMin` using `cmp` to order the values.
+// Pass a method expression such as `Version.PartialCmp` to use it
+// with a `PartialOrd` type.
+func PartialMinFunc[T any](a, b T, cmp func(a, b T) option.Option[Ordering]) option.Option[T] {
+	o := cmp(a, b)
+	if o.IsNone() {
+		return option.None[T]()
+	}
+
+	if o.Unwrap().IsLe() {
+		return option.Some(a)
+	}
+
+	return option.Some(b)
+}
+
+// isNaN reports whether `v` is a NaN. Only floating-point values
+// can be unequal to themselves.
+func isNaN[T Ordered](v T) bool {
+	return v != v
+}
@@ -0,0 +1,76 @@
+// Package cow is an implementation of clone-on-write values,
+// loosely modeled on Rust's `Cow`. A Cow starts out either borrowing
+// a shared value or owning its own copy, and only pays for a clone
+// the first time a borrowed value is mutated.
+package cow
+
+import (
+	"github.com/jwhittle933/rs.go/clone"
+)
+
+// Cow holds either a borrowed (shared) or an owned value.
+// The zero value is an owned zero `T`.
+type Cow[T any] struct {
+	ptr   *T
+	owned bool
+}
+
+// Borrowed returns a Cow sharing the value at `v`. The value is not
+// copied until `ToMut` is called, and must not be mutated through `v`
+// while the Cow is borrowing it.
+func Borrowed[T any](v *T) Cow[T] {
+	return Cow[T]{ptr: v}
+}
+
+// Owned returns a Cow that owns `v`.
+func Owned[T any](v T) Cow[T] {
+	return Cow[T]{ptr: &v, owned: true}
+}
+
+// IsOwned reports whether the Cow owns its value.
+func (c *Cow[T]) IsOwned() bool {
+	return c.ptr == nil || c.owned
+}
+
+// IsBorrowed reports whether the Cow still shares its value.
+func (c *Cow[T]) IsBorrowed() bool {
+	return !c.IsOwned()
+}
+
+// Get returns the current value, borrowed or owned.
+func (c *Cow[T]) Get() T {
+	if c.ptr == nil {
+		var zero T
+		return zero
+	}
+
+	return *c.ptr
+}
+
+// ToMut returns a pointer to an owned value that can be mutated.
+// If the Cow is borrowing, `cloneFn` is called once to produce the
+// owned copy; subsequent calls return the same pointer without cloning.
+// For slices and maps, `cloneFn` must copy the backing storage, not just
+// the header. A nil `cloneFn` uses `clone.Of`.
+func (c *Cow[T]) ToMut(cloneFn func(T) T) *T {
+	if c.ptr == nil {
+		c.ptr, c.owned = new(T), true
+	}
+
+	if !c.owned {
+		if cloneFn == nil {
+			cloneFn = clone.Of[T]
+		}
+
+		v := cloneFn(*c.ptr)
+		c.ptr, c.owned = &v, true
+	}
+
+	return c.ptr
+}
+
+// IntoOwned returns the value, cloning it with `cloneFn` if it is
+// still borrowed. A nil `cloneFn` uses `clone.Of`.
+func (c *Cow[T]) IntoOwned(cloneFn func(T) T) T {
+	return *c.ToMut(cloneFn)
+}
@@ -0,0 +1,67 @@
+// Package ctxx provides typed access to `context.Context` values. A
+// missing value, or one of the wrong type, reads as `None` instead of a
+// nil interface that panics on assertion.
+package ctxx
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Value returns the value stored in `ctx` under `key` if there is one
+// and it is a `T`, and `None` otherwise.
+func Value[T any](ctx context.Context, key any) option.Option[T] {
+	if v, ok := ctx.Value(key).(T); ok {
+		return option.Some(v)
+	}
+
+	return option.None[T]()
+}
+
+// MustValue returns the value stored in `ctx` under `key`, panicking
+// if there is none or it is not a `T`. Use it only for values that
+// middleware is guaranteed to have set.
+func MustValue[T any](ctx context.Context, key any) T {
+	return Value[T](ctx, key).Expect(fmt.Sprintf("ctxx: no %s value for key %v", typeName[T](), key))
+}
+
+// Key is a typed context key. Each key created by `NewKey` is distinct,
+// even from another with the same name, so packages cannot collide.
+type Key[T any] struct {
+	name string
+}
+
+// NewKey returns a new key for values of type `T`. `name` is used only
+// for debugging.
+func NewKey[T any](name string) *Key[T] {
+	return &Key[T]{name: name}
+}
+
+// Set returns a copy of `ctx` with `v` stored under `k`.
+func (k *Key[T]) Set(ctx context.Context, v T) context.Context {
+	return context.WithValue(ctx, k, v)
+}
+
+// Get returns the value stored under `k` in `ctx`, or `None`.
+func (k *Key[T]) Get(ctx context.Context) option.Option[T] {
+	return Value[T](ctx, k)
+}
+
+// MustGet returns the value stored under `k` in `ctx`, panicking if
+// there is none.
+func (k *Key[T]) MustGet(ctx context.Context) T {
+	return k.Get(ctx).Expect(fmt.Sprintf("ctxx: no value for key %s", k))
+}
+
+// String returns the name of the key, which `context` uses when
+// printing a context.
+func (k *Key[T]) String() string {
+	return fmt.Sprintf("ctxx.Key[%s](%s)", typeName[T](), k.name)
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
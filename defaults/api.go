@@ -0,0 +1,33 @@
+// Package defaults is an implementation of sensible default values,
+// loosely modeled on Rust's `Default` trait. Types that have a better
+// default than their zero value implement `Default`, and `Of` finds it.
+package defaults
+
+// Default is implemented by types that can construct their own
+// default value. The method is called on the zero value of the type,
+// so it must not rely on the receiver's fields.
+type Default[T any] interface {
+	Default() T
+}
+
+// Zero returns the zero value of `T`.
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+// Of returns the default value of `T`: the result of its `Default`
+// method if `T` or `*T` implements `Default[T]`, otherwise its zero
+// value.
+func Of[T any]() T {
+	var zero T
+	if d, ok := any(zero).(Default[T]); ok {
+		return d.Default()
+	}
+
+	if d, ok := any(&zero).(Default[T]); ok {
+		return d.Default()
+	}
+
+	return zero
+}
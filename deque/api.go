@@ -0,0 +1,138 @@
+// Package deque is an implementation of a double-ended queue, loosely
+// modeled on Rust's `VecDeque`. It is backed by a ring buffer, so
+// pushing and popping at either end is O(1) amortized.
+package deque
+
+import (
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Deque is a double-ended queue of `T`. The zero value is an empty
+// Deque ready to use. A Deque is not safe for concurrent use.
+type Deque[T any] struct {
+	buf  []T
+	head int
+	len  int
+}
+
+// New returns an empty Deque.
+func New[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// WithCapacity returns an empty Deque with room for `n` items.
+func WithCapacity[T any](n int) *Deque[T] {
+	return &Deque[T]{buf: make([]T, n)}
+}
+
+// Len returns the number of items.
+func (d *Deque[T]) Len() int {
+	return d.len
+}
+
+// IsEmpty reports whether the Deque has no items.
+func (d *Deque[T]) IsEmpty() bool {
+	return d.len == 0
+}
+
+// index maps a logical position to a position in the ring buffer.
+func (d *Deque[T]) index(i int) int {
+	return (d.head + i) % len(d.buf)
+}
+
+func (d *Deque[T]) grow() {
+	if d.len < len(d.buf) {
+		return
+	}
+
+	buf := make([]T, max(2*len(d.buf), 8))
+	n := copy(buf, d.buf[d.head:])
+	copy(buf[n:], d.buf[:d.head])
+	d.buf, d.head = buf, 0
+}
+
+// PushBack adds `v` to the back of the Deque.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[d.index(d.len)] = v
+	d.len++
+}
+
+// PushFront adds `v` to the front of the Deque.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.len++
+}
+
+// PopFront removes and returns the front item, or None if the Deque
+// is empty.
+func (d *Deque[T]) PopFront() option.Option[T] {
+	if d.len == 0 {
+		return option.None[T]()
+	}
+
+	var zero T
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = d.index(1)
+	d.len--
+	return option.Some(v)
+}
+
+// PopBack removes and returns the back item, or None if the Deque
+// is empty.
+func (d *Deque[T]) PopBack() option.Option[T] {
+	if d.len == 0 {
+		return option.None[T]()
+	}
+
+	var zero T
+	i := d.index(d.len - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.len--
+	return option.Some(v)
+}
+
+// Get returns the item at position `i` from the front, or None if
+// `i` is out of range.
+func (d *Deque[T]) Get(i int) option.Option[T] {
+	if i < 0 || i >= d.len {
+		return option.None[T]()
+	}
+
+	return option.Some(d.buf[d.index(i)])
+}
+
+// Front returns the front item, or None if the Deque is empty.
+func (d *Deque[T]) Front() option.Option[T] {
+	return d.Get(0)
+}
+
+// Back returns the back item, or None if the Deque is empty.
+func (d *Deque[T]) Back() option.Option[T] {
+	return d.Get(d.len - 1)
+}
+
+// Clear removes every item, keeping the allocated capacity.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.len = 0, 0
+}
+
+// Iter returns an Iterator over the items from front to back. The
+// Deque must not be modified while the Iterator is in use.
+func (d *Deque[T]) Iter() iter.Iterator[T] {
+	i := 0
+	return iter.Func[T](func() option.Option[T] {
+		v := d.Get(i)
+		if v.IsSome() {
+			i++
+		}
+
+		return v
+	})
+}
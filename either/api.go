@@ -0,0 +1,60 @@
+// Package either is an implementation of a value that is one of two
+// types, loosely modeled on Rust's `either` crate. Unlike a `Result`,
+// neither side is considered a failure.
+package either
+
+import (
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Either holds either a left value of type `L` or a right value of
+// type `R`.
+type Either[L any, R any] struct {
+	left  *L
+	right *R
+}
+
+// Left returns an Either holding the left value `v`.
+func Left[L any, R any](v L) Either[L, R] {
+	return Either[L, R]{left: &v}
+}
+
+// Right returns an Either holding the right value `v`.
+func Right[L any, R any](v R) Either[L, R] {
+	return Either[L, R]{right: &v}
+}
+
+// IsLeft reports whether the Either holds a left value.
+func (e Either[L, R]) IsLeft() bool {
+	return e.left != nil
+}
+
+// IsRight reports whether the Either holds a right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.right != nil
+}
+
+// Left returns the left value wrapped in an Option, or None if the
+// Either holds a right value.
+func (e Either[L, R]) Left() option.Option[L] {
+	if e.IsLeft() {
+		return option.Some(*e.left)
+	}
+
+	return option.None[L]()
+}
+
+// Right returns the right value wrapped in an Option, or None if the
+// Either holds a left value.
+func (e Either[L, R]) Right() option.Option[R] {
+	if e.IsRight() {
+		return option.Some(*e.right)
+	}
+
+	return option.None[R]()
+}
+
+// Flip returns an Either with the sides exchanged.
+func (e Either[L, R]) Flip() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left}
+}
@@ -0,0 +1,165 @@
+// Package env reads typed configuration from environment variables.
+// Values are converted with the `parse` package, so any type that
+// `parse.Parse` handles, including user types that implement
+// `parse.FromStr`, can be read from the environment.
+package env
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/jwhittle933/rs.go/errorx"
+	"github.com/jwhittle933/rs.go/parse"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+var (
+	// ErrMissing is wrapped by the error for a required variable that
+	// is not set.
+	ErrMissing = errors.New("not set")
+	// ErrInvalidTarget is returned by `Load` when it is not given a
+	// non-nil pointer to a struct.
+	ErrInvalidTarget = errors.New("env: Load requires a non-nil pointer to a struct")
+)
+
+// VarError reports a variable that is missing or could not be parsed.
+type VarError struct {
+	Key string
+	Err error
+}
+
+func (e *VarError) Error() string {
+	return fmt.Sprintf("env: %s: %v", e.Key, e.Err)
+}
+
+func (e *VarError) Unwrap() error {
+	return e.Err
+}
+
+// Get reads the variable `key` and parses it as a `T`. It is an error
+// for the variable to be unset; a variable set to the empty string is
+// parsed as such.
+func Get[T any](key string) result.Result[T, error] {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return result.Err[T](error(&VarError{Key: key, Err: ErrMissing}))
+	}
+
+	return parse.Parse[T](s).MapErr(func(err error) error {
+		return &VarError{Key: key, Err: err}
+	})
+}
+
+// GetOr reads the variable `key` and parses it as a `T`, returning
+// `def` if the variable is unset. A variable that is set but does not
+// parse is still an error, rather than silently falling back to `def`.
+func GetOr[T any](key string, def T) result.Result[T, error] {
+	if _, ok := os.LookupEnv(key); !ok {
+		return result.Ok(def)
+	}
+
+	return Get[T](key)
+}
+
+// Load populates the struct pointed to by `cfg` from the environment,
+// using the field tags:
+//
+//	env:"NAME"           read the field from NAME
+//	env:"NAME,required"  fail if NAME is unset and there is no default
+//	default:"VALUE"      parse VALUE if NAME is unset
+//
+// Fields without an `env` tag are left alone, except nested structs,
+// which are loaded recursively. Slice fields whose type `parse` does
+// not handle directly are read as comma-separated lists. Every missing
+// or invalid variable is reported, joined with `errorx.Join`, rather
+// than only the first.
+func Load(cfg any) result.Result[struct{}, error] {
+	v := reflect.ValueOf(cfg)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return result.Err[struct{}](ErrInvalidTarget)
+	}
+
+	if err := errorx.Join(load(v.Elem())...); err != nil {
+		return result.Err[struct{}](err)
+	}
+
+	return result.Ok(struct{}{})
+}
+
+func load(v reflect.Value) []error {
+	var errs []error
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		tag, tagged := f.Tag.Lookup("env")
+		if !tagged {
+			if f.Type.Kind() == reflect.Struct {
+				errs = append(errs, load(v.Field(i))...)
+			}
+			continue
+		}
+
+		key, opts, _ := strings.Cut(tag, ",")
+		s, ok := os.LookupEnv(key)
+		if !ok {
+			s, ok = f.Tag.Lookup("default")
+		}
+
+		if !ok {
+			if opts == "required" {
+				errs = append(errs, &VarError{Key: key, Err: ErrMissing})
+			}
+			continue
+		}
+
+		fv, err := value(f.Type, s)
+		if err != nil {
+			errs = append(errs, &VarError{Key: key, Err: err})
+			continue
+		}
+
+		v.Field(i).Set(fv)
+	}
+
+	return errs
+}
+
+// value parses `s` as a `t`, splitting it on commas if `t` is a slice
+// that `parse` cannot handle as a whole.
+func value(t reflect.Type, s string) (reflect.Value, error) {
+	res := parse.ParseValue(t, s)
+	if res.IsOk() || t.Kind() != reflect.Slice || !errors.Is(res.UnwrapErr(), parse.ErrUnsupported) {
+		return unpack(res)
+	}
+
+	parts := strings.Split(s, ",")
+	if s == "" {
+		parts = nil
+	}
+
+	out := reflect.MakeSlice(t, len(parts), len(parts))
+	for i, part := range parts {
+		ev, err := unpack(parse.ParseValue(t.Elem(), strings.TrimSpace(part)))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		out.Index(i).Set(ev)
+	}
+
+	return out, nil
+}
+
+func unpack(res result.Result[reflect.Value, error]) (reflect.Value, error) {
+	if res.IsErr() {
+		return reflect.Value{}, res.UnwrapErr()
+	}
+
+	return res.Unwrap(), nil
+}
@@ -0,0 +1,159 @@
+// Package errorx is an implementation of a rich, dynamic error type,
+// loosely modeled on Rust's `anyhow`. An Error carries a message, an
+// optional cause, structured context fields, and the stack at which
+// it was created, so the `E` of a `Result` need not degrade to a bare
+// string.
+package errorx
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Error is a message with an optional cause, context fields, and the
+// stack at which it was created.
+type Error struct {
+	msg    string
+	cause  error
+	fields []any
+	kind   *Kind
+	trace  Backtrace
+}
+
+// New returns an Error with `msg` and no cause.
+func New(msg string) *Error {
+	return &Error{msg: msg, trace: Capture(1)}
+}
+
+// Errorf returns an Error whose message is formatted from `format`
+// and `args`. Unlike `fmt.Errorf`, `%w` is not interpreted; use `Wrap`
+// to attach a cause.
+func Errorf(format string, args ...any) *Error {
+	return &Error{msg: fmt.Sprintf(format, args...), trace: Capture(1)}
+}
+
+// Wrap returns an Error with `msg` whose cause is `err`, or nil if
+// `err` is nil, so it can wrap the result of a call unchecked:
+// `return errorx.Wrap(f(), "loading config")`. The result is an
+// `error` rather than an `*Error` so that nil stays a nil interface.
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+
+	return &Error{msg: msg, cause: err, trace: Capture(1)}
+}
+
+// Wrapf is `Wrap` with a formatted message.
+func Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	return &Error{msg: fmt.Sprintf(format, args...), cause: err, trace: Capture(1)}
+}
+
+func (e *Error) Error() string {
+	if e.cause == nil {
+		return e.msg
+	}
+
+	return e.msg + ": " + e.cause.Error()
+}
+
+// Unwrap returns the cause of the Error, if any.
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Message returns the Error's own message, without its cause.
+func (e *Error) Message() string {
+	return e.msg
+}
+
+// Context returns a copy of the Error with the alternating key-value
+// pairs in `kv` added to its fields, in the style of `log/slog`.
+// A trailing key without a value is recorded with the value nil.
+func (e *Error) Context(kv ...any) *Error {
+	cp := *e
+	cp.fields = append(append([]any(nil), e.fields...), kv...)
+	if len(cp.fields)%2 == 1 {
+		cp.fields = append(cp.fields, nil)
+	}
+
+	return &cp
+}
+
+// Fields returns the context fields of the Error as a map. Keys that
+// are not strings are formatted with `fmt.Sprint`; a repeated key keeps
+// its last value.
+func (e *Error) Fields() map[string]any {
+	out := make(map[string]any, len(e.fields)/2)
+	for i := 0; i+1 < len(e.fields); i += 2 {
+		key, ok := e.fields[i].(string)
+		if !ok {
+			key = fmt.Sprint(e.fields[i])
+		}
+
+		out[key] = e.fields[i+1]
+	}
+
+	return out
+}
+
+// Backtrace returns the stack at which the Error was created.
+func (e *Error) Backtrace() Backtrace {
+	return e.trace
+}
+
+// Format implements `fmt.Formatter`. `%v` and `%s` print the same
+// text as `Error`; `%+v` adds the context fields and stack trace, and
+// follows the cause chain.
+func (e *Error) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('+'):
+		io.WriteString(f, e.msg)
+		for i := 0; i+1 < len(e.fields); i += 2 {
+			fmt.Fprintf(f, " %v=%v", e.fields[i], e.fields[i+1])
+		}
+
+		fmt.Fprintf(f, "%+v", e.trace)
+
+		if e.cause != nil {
+			fmt.Fprintf(f, "\ncaused by: %+v", e.cause)
+		}
+	case verb == 'q':
+		fmt.Fprintf(f, "%q", e.Error())
+	default:
+		io.WriteString(f, e.Error())
+	}
+}
+
+// Downcast searches the chain of `err`, as `errors.As` does, for the
+// first error of type `T` and returns it. Unlike `errors.As`, `T` need
+// not implement `error`, so it may be any interface the caller wants
+// to test for.
+func Downcast[T any](err error) option.Option[T] {
+	if err == nil {
+		return option.None[T]()
+	}
+
+	if v, ok := err.(T); ok {
+		return option.Some(v)
+	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return Downcast[T](u.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, inner := range u.Unwrap() {
+			if found := Downcast[T](inner); found.IsSome() {
+				return found
+			}
+		}
+	}
+
+	return option.None[T]()
+}
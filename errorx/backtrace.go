@@ -0,0 +1,142 @@
+package errorx
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+)
+
+// Frame is a single function call in a Backtrace.
+type Frame struct {
+	Function string `json:"function"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+}
+
+// Package returns the import path of the package the Frame's
+// function belongs to.
+func (f Frame) Package() string {
+	slash := strings.LastIndex(f.Function, "/")
+	if dot := strings.Index(f.Function[slash+1:], "."); dot >= 0 {
+		return f.Function[:slash+1+dot]
+	}
+
+	return f.Function
+}
+
+// IsStdlib reports whether the Frame belongs to the Go runtime or
+// standard library, judged by the first element of its import path
+// having no dot. The `main` package is not considered standard.
+func (f Frame) IsStdlib() bool {
+	pkg := f.Package()
+	if pkg == "main" {
+		return false
+	}
+
+	first, _, _ := strings.Cut(pkg, "/")
+	return !strings.Contains(first, ".")
+}
+
+// Backtrace is a captured call stack. Capturing only records program
+// counters; resolving them to function names and lines is deferred
+// until the frames are asked for, so a Backtrace that is never printed
+// costs little.
+type Backtrace struct {
+	pcs     []uintptr
+	filters []func(Frame) bool
+}
+
+// Capture records the stack of its caller. `skip` is the number of
+// additional frames to omit, so a helper that captures on behalf of its
+// own caller passes 1.
+func Capture(skip int) Backtrace {
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(skip+2, pcs)
+	return Backtrace{pcs: pcs[:n]}
+}
+
+// Filter returns a Backtrace that keeps only the frames for which
+// `keep` returns true. Filters accumulate and are applied lazily.
+func (b Backtrace) Filter(keep func(Frame) bool) Backtrace {
+	b.filters = append(append([]func(Frame) bool(nil), b.filters...), keep)
+	return b
+}
+
+// WithoutStdlib returns a Backtrace without runtime and standard
+// library frames.
+func (b Backtrace) WithoutStdlib() Backtrace {
+	return b.Filter(func(f Frame) bool { return !f.IsStdlib() })
+}
+
+// WithoutPackage returns a Backtrace without frames from packages
+// whose import path starts with `prefix`.
+func (b Backtrace) WithoutPackage(prefix string) Backtrace {
+	return b.Filter(func(f Frame) bool { return !strings.HasPrefix(f.Package(), prefix) })
+}
+
+// Frames resolves and returns the frames of the Backtrace, innermost
+// first, with filters applied.
+func (b Backtrace) Frames() []Frame {
+	if len(b.pcs) == 0 {
+		return nil
+	}
+
+	var out []Frame
+	frames := runtime.CallersFrames(b.pcs)
+	for {
+		rf, more := frames.Next()
+		f := Frame{Function: rf.Function, File: rf.File, Line: rf.Line}
+		if b.keep(f) {
+			out = append(out, f)
+		}
+
+		if !more {
+			return out
+		}
+	}
+}
+
+func (b Backtrace) keep(f Frame) bool {
+	for _, keep := range b.filters {
+		if !keep(f) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsEmpty reports whether no stack was captured.
+func (b Backtrace) IsEmpty() bool {
+	return len(b.pcs) == 0
+}
+
+// Format implements `fmt.Formatter`. `%v` and `%s` print one
+// `function (file:line)` per line; `%+v` prints the function and
+// location on separate, indented lines, in the style of a Go panic.
+func (b Backtrace) Format(f fmt.State, verb rune) {
+	for i, frame := range b.Frames() {
+		if verb == 'v' && f.Flag('+') {
+			fmt.Fprintf(f, "\n\t%s\n\t\t%s:%d", frame.Function, frame.File, frame.Line)
+			continue
+		}
+
+		if i > 0 {
+			io.WriteString(f, "\n")
+		}
+
+		fmt.Fprintf(f, "%s (%s:%d)", frame.Function, frame.File, frame.Line)
+	}
+}
+
+// MarshalJSON encodes the Backtrace as an array of frames.
+func (b Backtrace) MarshalJSON() ([]byte, error) {
+	frames := b.Frames()
+	if frames == nil {
+		frames = []Frame{}
+	}
+
+	return json.Marshal(frames)
+}
@@ -0,0 +1,120 @@
+package errorx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Kind is a named category of error, in the style of an enum variant
+// defined with Rust's `thiserror`. Kinds are compared by identity, so
+// each should be defined once, at package level:
+//
+//	var NotFound = errorx.Define("NotFound", 404, "%s %q not found")
+//
+//	err := NotFound.New("user", id)
+//	errors.Is(err, NotFound) // true
+type Kind struct {
+	name   string
+	code   int
+	format string
+}
+
+// Define returns a new Kind. `format` is a `fmt` template used to
+// build the message of errors of this Kind from the arguments given
+// to `New` and `Wrap`.
+func Define(name string, code int, format string) *Kind {
+	return &Kind{name: name, code: code, format: format}
+}
+
+// Name returns the name the Kind was defined with.
+func (k *Kind) Name() string {
+	return k.name
+}
+
+// Code returns the code the Kind was defined with.
+func (k *Kind) Code() int {
+	return k.code
+}
+
+// Error returns the Kind's name. Kind implements `error` so that it
+// can be the target of `errors.Is`.
+func (k *Kind) Error() string {
+	return k.name
+}
+
+// New returns an Error of this Kind whose message is the Kind's
+// template formatted with `args`.
+func (k *Kind) New(args ...any) *Error {
+	return &Error{msg: k.message(args), kind: k, trace: Capture(1)}
+}
+
+// Wrap returns an Error of this Kind whose cause is `err`, or nil if
+// `err` is nil. Like the `Wrap` function, it returns an `error` so
+// that nil stays a nil interface.
+func (k *Kind) Wrap(err error, args ...any) error {
+	if err == nil {
+		return nil
+	}
+
+	return &Error{msg: k.message(args), cause: err, kind: k, trace: Capture(1)}
+}
+
+// Has reports whether any error in the chain of `err` is of this Kind.
+// It is shorthand for `errors.Is(err, k)`.
+func (k *Kind) Has(err error) bool {
+	return errors.Is(err, k)
+}
+
+func (k *Kind) message(args []any) string {
+	if k.format == "" {
+		return k.name
+	}
+
+	return fmt.Sprintf(k.format, args...)
+}
+
+// Kind returns the Kind the Error was created with, or None if it was
+// created without one. It does not look at the cause; use `KindOf` to
+// search the whole chain.
+func (e *Error) Kind() option.Option[*Kind] {
+	if e.kind == nil {
+		return option.None[*Kind]()
+	}
+
+	return option.Some(e.kind)
+}
+
+// Is reports whether `target` is the Error's Kind. It lets
+// `errors.Is(err, SomeKind)` match errors of that Kind.
+func (e *Error) Is(target error) bool {
+	k, ok := target.(*Kind)
+	return ok && e.kind != nil && e.kind == k
+}
+
+// KindOf returns the Kind of the first Error in the chain of `err`
+// that has one, or None if there is none. It suits `Result.MapErr`
+// and match arms that dispatch on the category of a failure.
+func KindOf(err error) option.Option[*Kind] {
+	if err == nil {
+		return option.None[*Kind]()
+	}
+
+	if e, ok := err.(*Error); ok && e.kind != nil {
+		return option.Some(e.kind)
+	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return KindOf(u.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, inner := range u.Unwrap() {
+			if found := KindOf(inner); found.IsSome() {
+				return found
+			}
+		}
+	}
+
+	return option.None[*Kind]()
+}
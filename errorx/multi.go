@@ -0,0 +1,63 @@
+package errorx
+
+import (
+	"strings"
+)
+
+// MultiError is a collection of errors reported together, such as
+// every failure from a validation pass or a fan-out. `errors.Is` and
+// `errors.As` match against each member.
+type MultiError struct {
+	errs []error
+}
+
+// Join returns a `*MultiError` holding the non-nil errors in `errs`,
+// or nil if there are none. Members that are themselves MultiErrors
+// are flattened into the result.
+func Join(errs ...error) error {
+	var out []error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if m, ok := err.(*MultiError); ok {
+			out = append(out, m.errs...)
+			continue
+		}
+
+		out = append(out, err)
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+
+	return &MultiError{errs: out}
+}
+
+// Errors returns the members of the MultiError.
+func (m *MultiError) Errors() []error {
+	return append([]error(nil), m.errs...)
+}
+
+// Len returns the number of members.
+func (m *MultiError) Len() int {
+	return len(m.errs)
+}
+
+// Error returns the messages of the members, one per line.
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.errs))
+	for i, err := range m.errs {
+		msgs[i] = err.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the members, which lets `errors.Is` and `errors.As`
+// inspect each of them.
+func (m *MultiError) Unwrap() []error {
+	return m.errs
+}
@@ -0,0 +1,123 @@
+// Package execx runs external commands and reports the outcome as a
+// `Result`, distinguishing a command that could not be started from
+// one that ran and failed.
+package execx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Kind classifies an `ExecError`.
+type Kind int
+
+const (
+	// StartFailed means the command could not be started, for
+	// example because the executable was not found.
+	StartFailed Kind = iota
+	// NonZeroExit means the command ran and exited with a non-zero
+	// status.
+	NonZeroExit
+	// Timeout means the context's deadline passed before the command
+	// finished, and it was killed.
+	Timeout
+	// Canceled means the context was canceled before the command
+	// finished, and it was killed.
+	Canceled
+	// WaitFailed means the command started but waiting for it failed
+	// for a reason other than its exit status, such as an error
+	// copying its output.
+	WaitFailed
+)
+
+func (k Kind) String() string {
+	switch k {
+	case StartFailed:
+		return "start failed"
+	case NonZeroExit:
+		return "non-zero exit"
+	case Timeout:
+		return "timeout"
+	case Canceled:
+		return "canceled"
+	case WaitFailed:
+		return "wait failed"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Output is what a command wrote and how it exited. `ExitCode` is -1
+// if the command did not exit normally.
+type Output struct {
+	Stdout   []byte
+	Stderr   []byte
+	ExitCode int
+}
+
+// ExecError reports a command that did not run to a zero exit.
+// `Output` holds whatever the command produced before it failed.
+type ExecError struct {
+	Kind   Kind
+	Name   string
+	Args   []string
+	Output Output
+	Err    error
+}
+
+func (e *ExecError) Error() string {
+	cmd := strings.Join(append([]string{e.Name}, e.Args...), " ")
+	if e.Kind == NonZeroExit {
+		return fmt.Sprintf("execx: %s: exit status %d", cmd, e.Output.ExitCode)
+	}
+
+	return fmt.Sprintf("execx: %s: %s: %v", cmd, e.Kind, e.Err)
+}
+
+func (e *ExecError) Unwrap() error {
+	return e.Err
+}
+
+// Run runs the command `name` with `args`, capturing its output. The
+// command is killed if `ctx` is done before it finishes.
+func Run(ctx context.Context, name string, args ...string) result.Result[Output, *ExecError] {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	fail := func(kind Kind, err error) result.Result[Output, *ExecError] {
+		out := Output{Stdout: stdout.Bytes(), Stderr: stderr.Bytes(), ExitCode: -1}
+		if cmd.ProcessState != nil {
+			out.ExitCode = cmd.ProcessState.ExitCode()
+		}
+
+		return result.Err[Output](&ExecError{Kind: kind, Name: name, Args: args, Output: out, Err: err})
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fail(StartFailed, err)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		var exitErr *exec.ExitError
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			return fail(Timeout, ctx.Err())
+		case ctx.Err() != nil:
+			return fail(Canceled, ctx.Err())
+		case errors.As(err, &exitErr):
+			return fail(NonZeroExit, err)
+		default:
+			return fail(WaitFailed, err)
+		}
+	}
+
+	return result.OkOf[Output, *ExecError](Output{Stdout: stdout.Bytes(), Stderr: stderr.Bytes()})
+}
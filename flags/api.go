@@ -0,0 +1,265 @@
+// Package flags is a small command-line parser built on `Option` and
+// `Result`. A flag that was not given reads as `None` rather than a
+// zero value, and every problem with the command line is reported from
+// `Parse` at once rather than one at a time.
+//
+//	fs := flags.New("serve")
+//	port := flags.Define[uint16](fs, "port", "listen port").Default(8080)
+//	root := flags.Define[string](fs, "root", "directory to serve").Required()
+//	args := fs.Parse(os.Args[1:]).Expect(fs.Usage())
+//
+// Values are converted with the `parse` package, so any type it
+// handles can be used as a flag.
+package flags
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/jwhittle933/rs.go/errorx"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/parse"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+var (
+	// ErrHelp is returned by `Parse` when -h or --help is given.
+	ErrHelp = errors.New("flags: help requested")
+	// ErrMissing is wrapped by the error for a required flag that was
+	// not given.
+	ErrMissing = errors.New("required flag not given")
+	// ErrUnknown is wrapped by the error for a flag that was not
+	// defined.
+	ErrUnknown = errors.New("unknown flag")
+	// ErrNoValue is wrapped by the error for a non-boolean flag given
+	// without a value.
+	ErrNoValue = errors.New("flag needs a value")
+)
+
+// FlagError reports a problem with one flag.
+type FlagError struct {
+	Flag string
+	Err  error
+}
+
+func (e *FlagError) Error() string {
+	return fmt.Sprintf("flags: --%s: %v", e.Flag, e.Err)
+}
+
+func (e *FlagError) Unwrap() error {
+	return e.Err
+}
+
+// FlagSet is a set of defined flags.
+type FlagSet struct {
+	name  string
+	flags []*def
+	index map[string]*def
+	args  []string
+}
+
+// def is the untyped part of a `Flag`, shared with its `FlagSet`.
+type def struct {
+	name     string
+	usage    string
+	typ      reflect.Type
+	required bool
+	dflt     option.Option[string]
+	set      func(s string) error
+	isSet    func() bool
+}
+
+// New returns an empty FlagSet. `name` is used in the usage text.
+func New(name string) *FlagSet {
+	return &FlagSet{name: name, index: map[string]*def{}}
+}
+
+// Flag is a typed flag defined with `Define`.
+type Flag[T any] struct {
+	def   *def
+	value option.Option[T]
+	dflt  option.Option[T]
+}
+
+// Define adds a flag called `name` to `fs`. It may be given as
+// `--name value`, `--name=value`, or with a single dash. A `bool` flag
+// may be given alone to mean true. Defining the same name twice panics.
+func Define[T any](fs *FlagSet, name, usage string) *Flag[T] {
+	if _, ok := fs.index[name]; ok {
+		panic(fmt.Sprintf("flags: flag %q defined twice", name))
+	}
+
+	f := &Flag[T]{value: option.None[T](), dflt: option.None[T]()}
+	f.def = &def{
+		name:  name,
+		usage: usage,
+		typ:   reflect.TypeOf((*T)(nil)).Elem(),
+		dflt:  option.None[string](),
+		set: func(s string) error {
+			res := parse.Parse[T](s)
+			if res.IsErr() {
+				return res.UnwrapErr()
+			}
+
+			f.value = res.Ok()
+			return nil
+		},
+		isSet: func() bool { return f.value.IsSome() },
+	}
+	fs.flags = append(fs.flags, f.def)
+	fs.index[name] = f.def
+
+	return f
+}
+
+// Required makes it an error for the flag not to be given. A flag with
+// a default is never missing.
+func (f *Flag[T]) Required() *Flag[T] {
+	f.def.required = true
+	return f
+}
+
+// Default sets the value `Get` returns when the flag is not given.
+func (f *Flag[T]) Default(v T) *Flag[T] {
+	f.dflt = option.Some(v)
+	f.def.dflt = option.Some(fmt.Sprint(v))
+
+	return f
+}
+
+// Get returns the flag's value, its default if it was not given, or
+// `None` if it has neither.
+func (f *Flag[T]) Get() option.Option[T] {
+	if f.value.IsSome() {
+		return f.value
+	}
+
+	return f.dflt
+}
+
+// IsSet reports whether the flag was given on the command line.
+func (f *Flag[T]) IsSet() bool {
+	return f.value.IsSome()
+}
+
+// Parse parses `args`, which should not include the program name, and
+// returns the positional arguments. Flags and positional arguments may
+// be mixed; everything after "--" is positional, as is any argument
+// such as "-5" that reads as a number and names no flag. All unknown
+// flags, unparseable values, and missing required flags are joined
+// into one error with `errorx.Join`. If -h or --help is given, `ErrHelp` is
+// returned and nothing else is checked.
+func (fs *FlagSet) Parse(args []string) result.Result[[]string, error] {
+	var errs []error
+	var positional []string
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+			break
+		}
+
+		if len(arg) < 2 || arg[0] != '-' {
+			positional = append(positional, arg)
+			continue
+		}
+
+		flag := strings.TrimPrefix(arg, "-")
+		flag = strings.TrimPrefix(flag, "-")
+		name, value, hasValue := strings.Cut(flag, "=")
+		if name == "h" || name == "help" {
+			return result.Err[[]string](ErrHelp)
+		}
+
+		d, ok := fs.index[name]
+		if !ok && isNumber(arg) {
+			positional = append(positional, arg)
+			continue
+		}
+
+		if !ok {
+			errs = append(errs, &FlagError{Flag: name, Err: ErrUnknown})
+			continue
+		}
+
+		if !hasValue {
+			switch {
+			case d.typ.Kind() == reflect.Bool:
+				value = "true"
+			case i+1 < len(args):
+				i++
+				value = args[i]
+			default:
+				errs = append(errs, &FlagError{Flag: name, Err: ErrNoValue})
+				continue
+			}
+		}
+
+		if err := d.set(value); err != nil {
+			errs = append(errs, &FlagError{Flag: name, Err: err})
+		}
+	}
+
+	for _, d := range fs.flags {
+		if d.required && d.dflt.IsNone() && !d.isSet() {
+			errs = append(errs, &FlagError{Flag: d.name, Err: ErrMissing})
+		}
+	}
+
+	if err := errorx.Join(errs...); err != nil {
+		return result.Err[[]string](err)
+	}
+
+	fs.args = positional
+	return result.Ok(positional)
+}
+
+// Args returns the positional arguments from the last successful
+// `Parse`.
+func (fs *FlagSet) Args() []string {
+	return fs.args
+}
+
+// Usage returns help text listing every flag in the order defined,
+// with its type, description, and default or required marker.
+func (fs *FlagSet) Usage() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Usage: %s [flags] [args...]\n", fs.name)
+	if len(fs.flags) == 0 {
+		return b.String()
+	}
+
+	heads := make([]string, len(fs.flags))
+	width := 0
+	for i, d := range fs.flags {
+		heads[i] = "--" + d.name
+		if d.typ.Kind() != reflect.Bool {
+			heads[i] += " " + d.typ.String()
+		}
+		width = max(width, len(heads[i]))
+	}
+
+	b.WriteString("\nFlags:\n")
+	for i, d := range fs.flags {
+		fmt.Fprintf(&b, "  %-*s  %s", width, heads[i], d.usage)
+		switch {
+		case d.dflt.IsSome():
+			fmt.Fprintf(&b, " (default %s)", d.dflt.Unwrap())
+		case d.required:
+			b.WriteString(" (required)")
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// isNumber reports whether `arg` reads as a number, such as a negative
+// positional argument.
+func isNumber(arg string) bool {
+	_, err := strconv.ParseFloat(arg, 64)
+	return err == nil
+}
@@ -0,0 +1,175 @@
+// Package flow assembles `Result`-returning functions into pipelines of
+// named steps, in the style of railway-oriented programming: each step
+// runs only if the one before it succeeded, and the first failure is
+// carried to the end. Running a pipeline also records a `Trace` of
+// which steps ran, how long each took, and which one failed.
+//
+//	parse := flow.Step("parse", parseOrder)
+//	price := flow.Step("price", priceOrder)
+//	save := flow.Step("save", saveOrder)
+//	res, trace := flow.Then(flow.Then(parse, price), save).Run(ctx, body)
+package flow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// StepError reports the step in which a pipeline failed.
+type StepError struct {
+	Step string
+	Err  error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("flow: step %q: %v", e.Step, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// step is the untyped form of a step. Types are checked when the
+// pipeline is built, so the assertion in `Step` fails only for a nil
+// interface value, which it passes on as the zero `In`.
+type step struct {
+	name string
+	run  func(ctx context.Context, in any) (any, error)
+}
+
+// Flow is a pipeline that turns an `In` into an `Out`.
+type Flow[In, Out any] struct {
+	steps []step
+}
+
+// Step returns a one-step pipeline running `fn` under `name`.
+func Step[In, Out any](name string, fn func(ctx context.Context, in In) result.Result[Out, error]) Flow[In, Out] {
+	return Flow[In, Out]{steps: []step{{
+		name: name,
+		run: func(ctx context.Context, in any) (any, error) {
+			typed, _ := in.(In)
+			res := fn(ctx, typed)
+			if res.IsErr() {
+				return nil, res.UnwrapErr()
+			}
+
+			return res.Unwrap(), nil
+		},
+	}}}
+}
+
+// Then returns a pipeline that runs `first` and then `next` on its
+// output.
+func Then[A, B, C any](first Flow[A, B], next Flow[B, C]) Flow[A, C] {
+	steps := make([]step, 0, len(first.steps)+len(next.steps))
+	steps = append(steps, first.steps...)
+	steps = append(steps, next.steps...)
+
+	return Flow[A, C]{steps: steps}
+}
+
+// Chain joins pipelines that keep the same type, running them in order.
+func Chain[T any](flows ...Flow[T, T]) Flow[T, T] {
+	var steps []step
+	for _, f := range flows {
+		steps = append(steps, f.steps...)
+	}
+
+	return Flow[T, T]{steps: steps}
+}
+
+// Names returns the names of the pipeline's steps, in order.
+func (f Flow[In, Out]) Names() []string {
+	names := make([]string, len(f.steps))
+	for i, s := range f.steps {
+		names[i] = s.name
+	}
+
+	return names
+}
+
+// Run runs the pipeline on `in`. It stops at the first step that fails
+// and returns its error wrapped in a `*StepError`. If `ctx` is done
+// before a step starts, that step fails with the context's error
+// without running. A pipeline with no steps returns `in` if `In` and
+// `Out` are the same type.
+func (f Flow[In, Out]) Run(ctx context.Context, in In) (result.Result[Out, error], Trace) {
+	trace := Trace{Steps: make([]StepTrace, 0, len(f.steps))}
+	var v any = in
+	for _, s := range f.steps {
+		st := StepTrace{Name: s.name}
+		if err := ctx.Err(); err != nil {
+			st.Err = err
+			trace.Steps = append(trace.Steps, st)
+			return result.Err[Out](error(&StepError{Step: s.name, Err: err})), trace
+		}
+
+		start := time.Now()
+		out, err := s.run(ctx, v)
+		st.Duration = time.Since(start)
+		st.Err = err
+		trace.Steps = append(trace.Steps, st)
+		if err != nil {
+			return result.Err[Out](error(&StepError{Step: s.name, Err: err})), trace
+		}
+		v = out
+	}
+
+	out, ok := v.(Out)
+	if !ok && v != nil {
+		return result.Err[Out](fmt.Errorf("flow: empty pipeline cannot turn %T into the output type", v)), trace
+	}
+
+	return result.Ok(out), trace
+}
+
+// StepTrace records one step of a run.
+type StepTrace struct {
+	Name     string
+	Duration time.Duration
+	Err      error
+}
+
+// Trace records the steps of a run, in the order they ran. Steps after
+// a failure did not run and do not appear.
+type Trace struct {
+	Steps []StepTrace
+}
+
+// Failed returns the step that failed, or `None` if the run succeeded.
+func (t Trace) Failed() option.Option[StepTrace] {
+	if n := len(t.Steps); n > 0 && t.Steps[n-1].Err != nil {
+		return option.Some(t.Steps[n-1])
+	}
+
+	return option.None[StepTrace]()
+}
+
+// Total returns the time spent in all steps.
+func (t Trace) Total() time.Duration {
+	var total time.Duration
+	for _, s := range t.Steps {
+		total += s.Duration
+	}
+
+	return total
+}
+
+// String returns one line per step with its duration and outcome.
+func (t Trace) String() string {
+	var b strings.Builder
+	for _, s := range t.Steps {
+		fmt.Fprintf(&b, "%s\t%s", s.Name, s.Duration)
+		if s.Err != nil {
+			fmt.Fprintf(&b, "\tfailed: %v", s.Err)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
@@ -0,0 +1,87 @@
+// Package fs wraps common filesystem operations from `os` so that they
+// return `Result` and `Option` rather than a value and an error.
+package fs
+
+import (
+	"io"
+	iofs "io/fs"
+	"os"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// OpenResult is the `Result` of opening a file. It embeds
+// `result.Result[*os.File, error]`, so every `Result` method is
+// available, and adds helpers that chain on the open file. Go methods
+// cannot introduce type parameters, so these helpers are what make
+// `fs.Open(p).AndThenReadAll()` possible in a single chain.
+type OpenResult struct {
+	result.Result[*os.File, error]
+}
+
+// AndThenReadAll reads the rest of the open file and closes it. If the
+// file could not be opened, the open error is returned. An error from
+// `Close` is reported only if the read succeeded.
+func (r OpenResult) AndThenReadAll() result.Result[[]byte, error] {
+	if r.IsErr() {
+		return result.Err[[]byte](r.UnwrapErr())
+	}
+
+	f := r.Unwrap()
+	data, err := io.ReadAll(f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	return result.Match(data, err)
+}
+
+// AndThenClose closes the open file. If the file could not be opened,
+// the open error is returned.
+func (r OpenResult) AndThenClose() result.Result[struct{}, error] {
+	if r.IsErr() {
+		return result.Err[struct{}](r.UnwrapErr())
+	}
+
+	return result.Match(struct{}{}, r.Unwrap().Close())
+}
+
+// Open opens the named file for reading, as `os.Open` does.
+func Open(path string) OpenResult {
+	return OpenResult{result.Match(os.Open(path))}
+}
+
+// Create creates or truncates the named file, as `os.Create` does.
+func Create(path string) OpenResult {
+	return OpenResult{result.Match(os.Create(path))}
+}
+
+// ReadFile reads the whole of the named file.
+func ReadFile(path string) result.Result[[]byte, error] {
+	return result.Match(os.ReadFile(path))
+}
+
+// WriteFile writes `data` to the named file, creating it with `perm`
+// if needed and truncating it otherwise.
+func WriteFile(path string, data []byte, perm iofs.FileMode) result.Result[struct{}, error] {
+	return result.Match(struct{}{}, os.WriteFile(path, data, perm))
+}
+
+// Stat returns the `FileInfo` for the named file, following symlinks.
+func Stat(path string) result.Result[iofs.FileInfo, error] {
+	return result.Match(os.Stat(path))
+}
+
+// Lookup returns the `FileInfo` for the named file, or `None` if it
+// does not exist. Other errors, such as a permission failure, are also
+// reported as `None`; use `Stat` to tell them apart.
+func Lookup(path string) option.Option[iofs.FileInfo] {
+	return Stat(path).Ok()
+}
+
+// Exists reports whether the named file exists. Like `Lookup`, it
+// reports false for a file that cannot be stat'd for any reason.
+func Exists(path string) bool {
+	return Lookup(path).IsSome()
+}
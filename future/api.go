@@ -0,0 +1,91 @@
+// Package future is an implementation of values computed
+// concurrently, loosely modeled on Rust's `Future`. A Future starts
+// running as soon as it is spawned and always resolves to a
+// `Result`, including when its function panics.
+package future
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// PanicError is the error a Future resolves to when its function
+// panics.
+type PanicError = result.PanicError
+
+// Future is a Result that will be available at some point. It is
+// safe to await from any number of goroutines.
+type Future[T any] struct {
+	done chan struct{}
+	once sync.Once
+	res  result.Result[T, error]
+}
+
+func pending[T any]() *Future[T] {
+	return &Future[T]{done: make(chan struct{})}
+}
+
+// resolve settles the Future. Only the first call has any effect.
+func (f *Future[T]) resolve(res result.Result[T, error]) {
+	f.once.Do(func() {
+		f.res = res
+		close(f.done)
+	})
+}
+
+// Spawn runs `fn` in a new goroutine and returns a Future for its
+// outcome. If `fn` panics, the Future resolves to an error Result
+// holding a `*PanicError`.
+func Spawn[T any](fn func() (T, error)) *Future[T] {
+	f := pending[T]()
+	go func() {
+		f.resolve(result.CatchResult(func() result.Result[T, error] { return result.Match(fn()) }))
+	}()
+
+	return f
+}
+
+// Ready returns a Future that has already resolved to `res`.
+func Ready[T any](res result.Result[T, error]) *Future[T] {
+	f := pending[T]()
+	f.resolve(res)
+	return f
+}
+
+// Await blocks until the Future resolves and returns its Result.
+func (f *Future[T]) Await() result.Result[T, error] {
+	<-f.done
+	return f.res
+}
+
+// AwaitCtx is like `Await`, but gives up when `ctx` is done,
+// returning an error Result holding `ctx.Err()`. Giving up does not
+// stop the Future; it can still be awaited later.
+func (f *Future[T]) AwaitCtx(ctx context.Context) result.Result[T, error] {
+	select {
+	case <-f.done:
+		return f.res
+	case <-ctx.Done():
+		return result.Err[T](ctx.Err())
+	}
+}
+
+// TryGet returns the Result if the Future has resolved, or None if
+// it is still pending. It never blocks.
+func (f *Future[T]) TryGet() option.Option[result.Result[T, error]] {
+	select {
+	case <-f.done:
+		return option.Some(f.res)
+	default:
+		return option.None[result.Result[T, error]]()
+	}
+}
+
+// Done returns a channel that is closed when the Future resolves,
+// for use in a `select`.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
@@ -0,0 +1,52 @@
+package future
+
+// Then returns a Future that, once `f` resolves ok, resolves to the
+// outcome of calling `fn` with its data. If `f` fails, the new Future
+// resolves to the same error without calling `fn`. Then does not block.
+func Then[T any, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	return Spawn(func() (U, error) {
+		res := f.Await()
+		if res.IsErr() {
+			var zero U
+			return zero, res.UnwrapErr()
+		}
+
+		return fn(res.Unwrap())
+	})
+}
+
+// AndThen is like `Then` for functions that start another Future.
+// The returned Future resolves when the inner one does.
+func AndThen[T any, U any](f *Future[T], fn func(T) *Future[U]) *Future[U] {
+	return Then(f, func(data T) (U, error) {
+		res := fn(data).Await()
+		if res.IsErr() {
+			var zero U
+			return zero, res.UnwrapErr()
+		}
+
+		return res.Unwrap(), nil
+	})
+}
+
+// Map returns a Future that resolves to `fn` applied to the data of
+// `f`, or to the error of `f` unchanged. Map does not block.
+func Map[T any, U any](f *Future[T], fn func(T) U) *Future[U] {
+	return Then(f, func(data T) (U, error) {
+		return fn(data), nil
+	})
+}
+
+// MapErr returns a Future that resolves to `fn` applied to the error
+// of `f`, or to the data of `f` unchanged. MapErr does not block.
+func MapErr[T any](f *Future[T], fn func(error) error) *Future[T] {
+	return Spawn(func() (T, error) {
+		res := f.Await()
+		if res.IsErr() {
+			var zero T
+			return zero, fn(res.UnwrapErr())
+		}
+
+		return res.Unwrap(), nil
+	})
+}
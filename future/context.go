@@ -0,0 +1,52 @@
+package future
+
+import (
+	"context"
+	"time"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// SpawnCtx runs `fn` in a new goroutine with `ctx` and returns a
+// Future for its outcome. If `ctx` is done before `fn` returns, the
+// Future resolves immediately to an error Result holding `ctx.Err()`;
+// `fn` keeps running until it notices the cancellation, and its
+// eventual outcome is discarded.
+func SpawnCtx[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) *Future[T] {
+	f := Spawn(func() (T, error) { return fn(ctx) })
+	if ctx.Done() == nil {
+		return f
+	}
+
+	return settleFirst(f, ctx.Done(), func() error { return ctx.Err() })
+}
+
+// Timeout returns a Future that resolves like `f`, or to an error
+// Result holding `context.DeadlineExceeded` if `f` has not resolved
+// within `d`. `f` itself is unaffected.
+func Timeout[T any](f *Future[T], d time.Duration) *Future[T] {
+	timer := time.NewTimer(d)
+	out := settleFirst(f, timer.C, func() error { return context.DeadlineExceeded })
+	go func() {
+		<-out.Done()
+		timer.Stop()
+	}()
+
+	return out
+}
+
+// settleFirst returns a Future that resolves like `f`, unless `stop`
+// fires first, in which case it resolves to the error from `cause`.
+func settleFirst[T any, S any](f *Future[T], stop <-chan S, cause func() error) *Future[T] {
+	out := pending[T]()
+	go func() {
+		select {
+		case <-f.Done():
+			out.resolve(f.Await())
+		case <-stop:
+			out.resolve(result.Err[T](cause()))
+		}
+	}()
+
+	return out
+}
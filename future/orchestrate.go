@@ -0,0 +1,91 @@
+package future
+
+import (
+	"errors"
+
+	"github.com/jwhittle933/rs.go/result"
+	"github.com/jwhittle933/rs.go/tuple"
+)
+
+// ErrNoFutures is the error `Race` resolves to when given no Futures.
+var ErrNoFutures = errors.New("future: no futures to race")
+
+// Join returns a Future that resolves to a Pair of the data of `a`
+// and `b` once both succeed. If either fails, it resolves to that
+// error as soon as the failure is seen, without waiting for the other.
+func Join[A any, B any](a *Future[A], b *Future[B]) *Future[tuple.Pair[A, B]] {
+	return Spawn(func() (tuple.Pair[A, B], error) {
+		var zero tuple.Pair[A, B]
+		aDone, bDone := a.Done(), b.Done()
+		for aDone != nil || bDone != nil {
+			select {
+			case <-aDone:
+				if res := a.Await(); res.IsErr() {
+					return zero, res.UnwrapErr()
+				}
+
+				aDone = nil
+			case <-bDone:
+				if res := b.Await(); res.IsErr() {
+					return zero, res.UnwrapErr()
+				}
+
+				bDone = nil
+			}
+		}
+
+		return tuple.NewPair(a.Await().Unwrap(), b.Await().Unwrap()), nil
+	})
+}
+
+// All returns a Future that resolves to the data of every Future in
+// `fs`, in the same order, once all succeed. If any fails, it resolves
+// to the first error seen without waiting for the rest.
+func All[T any](fs ...*Future[T]) *Future[[]T] {
+	return Spawn(func() ([]T, error) {
+		done := completions(fs)
+		for range fs {
+			if res := fs[<-done].Await(); res.IsErr() {
+				return nil, res.UnwrapErr()
+			}
+		}
+
+		out := make([]T, len(fs))
+		for i, f := range fs {
+			out[i] = f.Await().Unwrap()
+		}
+
+		return out, nil
+	})
+}
+
+// Race returns a Future that resolves to the Result of whichever
+// Future in `fs` resolves first, ok or not. With no Futures it
+// resolves to `ErrNoFutures`.
+func Race[T any](fs ...*Future[T]) *Future[T] {
+	if len(fs) == 0 {
+		return Ready(result.Err[T](ErrNoFutures))
+	}
+
+	f := pending[T]()
+	go func() {
+		f.resolve(fs[<-completions(fs)].Await())
+	}()
+
+	return f
+}
+
+// completions returns a channel that receives the index of each
+// Future in `fs` as it resolves. The channel is buffered so the
+// watchers never block, even if the caller stops receiving.
+func completions[T any](fs []*Future[T]) <-chan int {
+	done := make(chan int, len(fs))
+	for i, f := range fs {
+		go func(i int, f *Future[T]) {
+			<-f.Done()
+			done <- i
+		}(i, f)
+	}
+
+	return done
+}
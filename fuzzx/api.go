@@ -0,0 +1,110 @@
+// Package fuzzx bridges native Go fuzzing with encoding round-trips.
+// Its helpers are called from a user's `FuzzXxx` function to check
+// that any input which decodes into a type encodes and decodes back
+// to the same value, which is where hand-written encoders for
+// `Option`- and `Result`-bearing structs tend to break.
+//
+//	func FuzzConfig(f *testing.F) {
+//		fuzzx.JSONRoundTrip(f, Config{Name: "a"})
+//	}
+package fuzzx
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// JSONRoundTrip seeds the corpus of `f` with the JSON encoding of each
+// of `seeds` and fuzzes `CheckJSON[T]` with it.
+func JSONRoundTrip[T any](f *testing.F, seeds ...T) {
+	f.Helper()
+	for _, seed := range seeds {
+		data, err := json.Marshal(seed)
+		if err != nil {
+			f.Fatalf("fuzzx: marshal seed %#v: %v", seed, err)
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := CheckJSON[T](data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// CheckJSON reports whether `data`, decoded as JSON into a `T`,
+// survives a further encode and decode unchanged. Input that does not
+// decode is not a failure.
+func CheckJSON[T any](data []byte) error {
+	var first T
+	if json.Unmarshal(data, &first) != nil {
+		return nil
+	}
+
+	encoded, err := json.Marshal(first)
+	if err != nil {
+		return fmt.Errorf("fuzzx: marshal decoded value %#v: %w", first, err)
+	}
+
+	var second T
+	if err := json.Unmarshal(encoded, &second); err != nil {
+		return fmt.Errorf("fuzzx: unmarshal %s: %w", encoded, err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		return fmt.Errorf("fuzzx: round trip changed value: %#v became %#v via %s", first, second, encoded)
+	}
+
+	return nil
+}
+
+// TextUnmarshaler is a pointer to `T` that decodes text, as the
+// pointer receivers of most `encoding.TextUnmarshaler` types do.
+type TextUnmarshaler[T any] interface {
+	*T
+	encoding.TextUnmarshaler
+}
+
+// TextRoundTrip seeds the corpus of `f` with each of `seeds` and fuzzes
+// `CheckText[T]` with it.
+func TextRoundTrip[T encoding.TextMarshaler, P TextUnmarshaler[T]](f *testing.F, seeds ...string) {
+	f.Helper()
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if err := CheckText[T, P](s); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// CheckText is `CheckJSON` for types that implement
+// `encoding.TextMarshaler` and `encoding.TextUnmarshaler`.
+func CheckText[T encoding.TextMarshaler, P TextUnmarshaler[T]](s string) error {
+	var first T
+	if P(&first).UnmarshalText([]byte(s)) != nil {
+		return nil
+	}
+
+	encoded, err := first.MarshalText()
+	if err != nil {
+		return fmt.Errorf("fuzzx: marshal decoded value %#v: %w", first, err)
+	}
+
+	var second T
+	if err := P(&second).UnmarshalText(encoded); err != nil {
+		return fmt.Errorf("fuzzx: unmarshal %q: %w", encoded, err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		return fmt.Errorf("fuzzx: round trip changed value: %#v became %#v via %q", first, second, encoded)
+	}
+
+	return nil
+}
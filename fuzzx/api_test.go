@@ -0,0 +1,40 @@
+package fuzzx_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jwhittle933/rs.go/fuzzx"
+	"github.com/jwhittle933/rs.go/parse/id"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+func FuzzUUIDText(f *testing.F) {
+	fuzzx.TextRoundTrip[id.UUID](f,
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+		"00000000-0000-0000-0000-000000000000",
+	)
+}
+
+func FuzzResultJSON(f *testing.F) {
+	fuzzx.JSONRoundTrip(f,
+		result.Ok(42),
+		result.Err[int](errors.New("failed")),
+		result.Result[int, error]{},
+	)
+}
+
+func FuzzResultStructJSON(f *testing.F) {
+	type response struct {
+		Items result.Result[[]string, string] `json:"items"`
+		Count result.Result[float64, error]   `json:"count"`
+	}
+
+	fuzzx.JSONRoundTrip(f,
+		response{Items: result.OkOf[[]string, string]([]string{"a", "b"}), Count: result.Ok(1.5)},
+		response{Items: result.Err[[]string]("missing"), Count: result.Err[float64](errors.New("overflow"))},
+	)
+}
@@ -1,3 +1,3 @@
 module github.com/jwhittle933/rs.go
 
-go 1.18
+go 1.21
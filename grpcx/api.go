@@ -0,0 +1,218 @@
+// Package grpcx converts between `Result` errors and gRPC statuses. An
+// `errorx.Error` with a `Kind` becomes a status with a canonical code
+// and an `ErrorInfo` detail carrying the Kind's name and the error's
+// fields, so service code can stay in terms of Results and Kinds and
+// leave the wire format to this boundary.
+//
+// It is a separate module so that the core packages do not depend on
+// gRPC.
+package grpcx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/jwhittle933/rs.go/errorx"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var kindCodes sync.Map // *errorx.Kind -> codes.Code
+
+// Register maps errors of `kind` to `code`, replacing the code that
+// would be derived from the Kind's own code.
+func Register(kind *errorx.Kind, code codes.Code) {
+	kindCodes.Store(kind, code)
+}
+
+// Code returns the gRPC code for `err`. In order: nil is `OK`; an
+// error carrying a gRPC status keeps its code; context cancellation
+// and deadlines map to `Canceled` and `DeadlineExceeded`; an error
+// with an `errorx.Kind` uses the code given to `Register` or, failing
+// that, the Kind's code read as an HTTP status; anything else is
+// `Unknown`.
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+
+	kind := errorx.KindOf(err)
+	if kind.IsNone() {
+		return codes.Unknown
+	}
+
+	if code, ok := kindCodes.Load(kind.Unwrap()); ok {
+		return code.(codes.Code)
+	}
+
+	return fromHTTP(kind.Unwrap().Code())
+}
+
+// fromHTTP maps an HTTP status code to the gRPC code that the gRPC
+// HTTP mapping documents for it.
+func fromHTTP(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusPreconditionFailed:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case 499:
+		return codes.Canceled
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+
+	if code >= 500 && code < 600 {
+		return codes.Internal
+	}
+
+	return codes.Unknown
+}
+
+// ToStatus converts `err` to a status. An error that already carries a
+// status is returned as is. Otherwise the code comes from `Code`, the
+// message is `err.Error()`, and if `err` has an `errorx.Kind`, an
+// `errdetails.ErrorInfo` is attached whose reason is the Kind's name
+// and whose metadata holds the fields of the error with that Kind. A
+// nil `err` gives an `OK` status.
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	st := status.New(Code(err), err.Error())
+	kind := errorx.KindOf(err)
+	if kind.IsNone() {
+		return st
+	}
+
+	info := &errdetails.ErrorInfo{Reason: kind.Unwrap().Name()}
+	if fields := kindFields(err); len(fields) > 0 {
+		info.Metadata = make(map[string]string, len(fields))
+		for k, v := range fields {
+			info.Metadata[k] = fmt.Sprint(v)
+		}
+	}
+
+	if detailed, derr := st.WithDetails(info); derr == nil {
+		return detailed
+	}
+
+	return st
+}
+
+// kindFields returns the fields of the first `errorx.Error` in the
+// chain of `err` that has a Kind, the same one `errorx.KindOf` finds.
+func kindFields(err error) map[string]any {
+	if e, ok := err.(*errorx.Error); ok && e.Kind().IsSome() {
+		return e.Fields()
+	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return kindFields(u.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, inner := range u.Unwrap() {
+			if fields := kindFields(inner); fields != nil {
+				return fields
+			}
+		}
+	}
+
+	return nil
+}
+
+// Error converts `err` to an error carrying a status, as returned by
+// gRPC handlers. A nil `err` gives nil.
+func Error(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return ToStatus(err).Err()
+}
+
+// StatusOf returns the status carried by `err`, or `None` if it does
+// not carry one. It suits errors returned by gRPC client calls.
+func StatusOf(err error) option.Option[*status.Status] {
+	if st, ok := status.FromError(err); ok && err != nil {
+		return option.Some(st)
+	}
+
+	return option.None[*status.Status]()
+}
+
+// FromResult unpacks `r` into the value and error a gRPC handler
+// returns, converting the error with `Error`.
+func FromResult[T any](r result.Result[T, error]) (T, error) {
+	if r.IsErr() {
+		var zero T
+		return zero, Error(r.UnwrapErr())
+	}
+
+	return r.Unwrap(), nil
+}
+
+// ToResult wraps the return of a gRPC client call in a Result. The
+// error, if any, is kept as is; use `StatusOf` to read its status.
+func ToResult[T any](v T, err error) result.Result[T, error] {
+	return result.Match(v, err)
+}
+
+// Unary adapts a handler written in terms of Results to the signature
+// gRPC service implementations use:
+//
+//	func (s *server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+//		return grpcx.Unary(s.getUser)(ctx, req)
+//	}
+func Unary[Req, Resp any](fn func(ctx context.Context, req Req) result.Result[Resp, error]) func(context.Context, Req) (Resp, error) {
+	return func(ctx context.Context, req Req) (Resp, error) {
+		return FromResult(fn(ctx, req))
+	}
+}
+
+// UnaryServerInterceptor returns an interceptor that converts every
+// error returned by a unary handler with `Error`, so handlers may
+// return `errorx` errors directly.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, Error(err)
+	}
+}
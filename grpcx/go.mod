@@ -0,0 +1,16 @@
+module github.com/jwhittle933/rs.go/grpcx
+
+go 1.21
+
+require (
+	github.com/jwhittle933/rs.go v0.0.0-20261015071530-29a2cb77310e
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240814211410-ddb44dafa142
+	google.golang.org/grpc v1.67.3
+)
+
+require (
+	golang.org/x/net v0.28.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
+	golang.org/x/text v0.17.0 // indirect
+	google.golang.org/protobuf v1.34.2 // indirect
+)
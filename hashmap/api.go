@@ -0,0 +1,157 @@
+// Package hashmap is an implementation of a hash map, loosely modeled
+// on Rust's `HashMap`. Lookups return `Option`s in place of Go's
+// comma-ok idiom, and the map's contents can be walked with iterators
+// from the `iter` package.
+package hashmap
+
+import (
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/tuple"
+)
+
+// Map is a hash map from `K` to `V`. The zero value is an empty Map
+// ready to use. A Map is not safe for concurrent use.
+type Map[K comparable, V any] struct {
+	m map[K]V
+}
+
+// New returns an empty Map.
+func New[K comparable, V any]() *Map[K, V] {
+	return &Map[K, V]{}
+}
+
+// WithCapacity returns an empty Map with room for `n` entries.
+func WithCapacity[K comparable, V any](n int) *Map[K, V] {
+	return &Map[K, V]{m: make(map[K]V, n)}
+}
+
+// From returns a Map holding the entries of `m`. The builtin map is
+// used as the backing store, not copied.
+func From[K comparable, V any](m map[K]V) *Map[K, V] {
+	return &Map[K, V]{m: m}
+}
+
+// Len returns the number of entries.
+func (m *Map[K, V]) Len() int {
+	return len(m.m)
+}
+
+// IsEmpty reports whether the Map has no entries.
+func (m *Map[K, V]) IsEmpty() bool {
+	return len(m.m) == 0
+}
+
+// Get returns the value for `k`, or None if there is none.
+func (m *Map[K, V]) Get(k K) option.Option[V] {
+	if v, ok := m.m[k]; ok {
+		return option.Some(v)
+	}
+
+	return option.None[V]()
+}
+
+// ContainsKey reports whether the Map has a value for `k`.
+func (m *Map[K, V]) ContainsKey(k K) bool {
+	_, ok := m.m[k]
+	return ok
+}
+
+// Insert sets the value for `k` to `v` and returns the value it
+// replaced, or None if `k` was not present.
+func (m *Map[K, V]) Insert(k K, v V) option.Option[V] {
+	if m.m == nil {
+		m.m = make(map[K]V)
+	}
+
+	prev := m.Get(k)
+	m.m[k] = v
+	return prev
+}
+
+// Remove deletes the value for `k` and returns it, or None if `k`
+// was not present.
+func (m *Map[K, V]) Remove(k K) option.Option[V] {
+	prev := m.Get(k)
+	delete(m.m, k)
+	return prev
+}
+
+// GetOrInsertWith returns the value for `k`, first setting it to the
+// result of `fn` if `k` has none. `fn` is only called when needed,
+// which suits lazily filled caches.
+func (m *Map[K, V]) GetOrInsertWith(k K, fn func() V) V {
+	return m.Entry(k).OrInsertWith(fn)
+}
+
+// Retain keeps only the entries for which `pred` returns true.
+func (m *Map[K, V]) Retain(pred func(k K, v V) bool) {
+	for k, v := range m.m {
+		if !pred(k, v) {
+			delete(m.m, k)
+		}
+	}
+}
+
+// Drain removes every entry and returns an Iterator over them as
+// key-value Pairs, in no particular order. The entries are removed
+// immediately, whether or not the Iterator is consumed.
+func (m *Map[K, V]) Drain() iter.Iterator[tuple.Pair[K, V]] {
+	drained := make([]tuple.Pair[K, V], 0, len(m.m))
+	for k, v := range m.m {
+		drained = append(drained, tuple.NewPair(k, v))
+	}
+
+	m.Clear()
+	return iter.FromSlice(drained)
+}
+
+// Clear removes every entry.
+func (m *Map[K, V]) Clear() {
+	clear(m.m)
+}
+
+// Keys returns an Iterator over the keys, in no particular order.
+func (m *Map[K, V]) Keys() iter.Iterator[K] {
+	return iter.FromSlice(m.keys())
+}
+
+// Values returns an Iterator over the values, in no particular order.
+func (m *Map[K, V]) Values() iter.Iterator[V] {
+	keys := iter.FromSlice(m.keys())
+	return iter.Func[V](func() option.Option[V] {
+		for k := keys.Next(); k.IsSome(); k = keys.Next() {
+			if v := m.Get(k.Unwrap()); v.IsSome() {
+				return v
+			}
+		}
+
+		return option.None[V]()
+	})
+}
+
+// Iter returns an Iterator over the entries as key-value Pairs, in
+// no particular order. The keys are captured when Iter is called;
+// values are read as the Iterator advances, and entries removed in
+// the meantime are skipped.
+func (m *Map[K, V]) Iter() iter.Iterator[tuple.Pair[K, V]] {
+	keys := iter.FromSlice(m.keys())
+	return iter.Func[tuple.Pair[K, V]](func() option.Option[tuple.Pair[K, V]] {
+		for k := keys.Next(); k.IsSome(); k = keys.Next() {
+			if v := m.Get(k.Unwrap()); v.IsSome() {
+				return option.Some(tuple.NewPair(k.Unwrap(), v.Unwrap()))
+			}
+		}
+
+		return option.None[tuple.Pair[K, V]]()
+	})
+}
+
+func (m *Map[K, V]) keys() []K {
+	keys := make([]K, 0, len(m.m))
+	for k := range m.m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
@@ -0,0 +1,65 @@
+package hashmap
+
+// Entry is a view of a single key in a Map, which may or may not
+// have a value. It is obtained from `Map.Entry` and lets a
+// read-modify-write be expressed as one chain.
+type Entry[K comparable, V any] struct {
+	m *Map[K, V]
+	k K
+}
+
+// Entry returns the Entry for `k`.
+func (m *Map[K, V]) Entry(k K) *Entry[K, V] {
+	return &Entry[K, V]{m: m, k: k}
+}
+
+// Key returns the key of the Entry.
+func (e *Entry[K, V]) Key() K {
+	return e.k
+}
+
+// IsOccupied reports whether the key has a value.
+func (e *Entry[K, V]) IsOccupied() bool {
+	return e.m.ContainsKey(e.k)
+}
+
+// OrInsert sets the value to `v` if the key has none, and returns
+// the value now stored.
+func (e *Entry[K, V]) OrInsert(v V) V {
+	return e.OrInsertWith(func() V { return v })
+}
+
+// OrInsertWith sets the value to the result of `fn` if the key has
+// none, and returns the value now stored. `fn` is only called when
+// needed.
+func (e *Entry[K, V]) OrInsertWith(fn func() V) V {
+	if cur := e.m.Get(e.k); cur.IsSome() {
+		return cur.Unwrap()
+	}
+
+	v := fn()
+	e.m.Insert(e.k, v)
+	return v
+}
+
+// OrDefault sets the value to the zero value of `V` if the key has
+// none, and returns the value now stored.
+func (e *Entry[K, V]) OrDefault() V {
+	var zero V
+	return e.OrInsert(zero)
+}
+
+// AndModify calls `fn` with a pointer to the value if the key has
+// one, storing whatever `fn` leaves there. It returns the Entry so
+// that an insert can follow:
+//
+//	m.Entry(word).AndModify(func(n *int) { *n++ }).OrInsert(1)
+func (e *Entry[K, V]) AndModify(fn func(v *V)) *Entry[K, V] {
+	if cur := e.m.Get(e.k); cur.IsSome() {
+		v := cur.Unwrap()
+		fn(&v)
+		e.m.m[e.k] = v
+	}
+
+	return e
+}
@@ -0,0 +1,187 @@
+package hashmap
+
+import (
+	"hash/maphash"
+	"sync"
+
+	"github.com/jwhittle933/rs.go/internal/shard"
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/tuple"
+)
+
+// Sync is a hash map that is safe for concurrent use. Entries are
+// spread over lock-guarded shards, so operations on different keys
+// rarely contend. Operations that span the whole map, such as `Len`
+// and `Iter`, visit the shards one at a time and so do not see a
+// single consistent snapshot under concurrent writes.
+//
+// The zero value is an empty map ready to use. A Sync must not be
+// copied after first use.
+type Sync[K comparable, V any] struct {
+	init   sync.Once
+	seed   maphash.Seed
+	shards [shard.Count]syncShard[K, V]
+}
+
+type syncShard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewSync returns an empty Sync map.
+func NewSync[K comparable, V any]() *Sync[K, V] {
+	return &Sync[K, V]{}
+}
+
+// shard returns the shard for `k`, picking the map's seed on first use
+// so that the zero value works. Shard maps are created on first write.
+func (s *Sync[K, V]) shard(k K) *syncShard[K, V] {
+	s.init.Do(func() { s.seed = maphash.MakeSeed() })
+	return &s.shards[shard.Index(s.seed, k)]
+}
+
+// Len returns the number of entries.
+func (s *Sync[K, V]) Len() int {
+	n := 0
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		n += len(sh.m)
+		sh.mu.RUnlock()
+	}
+
+	return n
+}
+
+// IsEmpty reports whether the map has no entries.
+func (s *Sync[K, V]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Get returns the value for `k`, or None if there is none.
+func (s *Sync[K, V]) Get(k K) option.Option[V] {
+	sh := s.shard(k)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+
+	if v, ok := sh.m[k]; ok {
+		return option.Some(v)
+	}
+
+	return option.None[V]()
+}
+
+// ContainsKey reports whether the map has a value for `k`.
+func (s *Sync[K, V]) ContainsKey(k K) bool {
+	return s.Get(k).IsSome()
+}
+
+// Insert sets the value for `k` to `v` and returns the value it
+// replaced, or None if `k` was not present.
+func (s *Sync[K, V]) Insert(k K, v V) option.Option[V] {
+	prev, _ := s.compute(k, func(option.Option[V]) option.Option[V] {
+		return option.Some(v)
+	})
+
+	return prev
+}
+
+// Remove deletes the value for `k` and returns it, or None if `k` was
+// not present.
+func (s *Sync[K, V]) Remove(k K) option.Option[V] {
+	prev, _ := s.compute(k, func(option.Option[V]) option.Option[V] {
+		return option.None[V]()
+	})
+
+	return prev
+}
+
+// GetOrInsertWith returns the value for `k`, first setting it to the
+// result of `fn` if `k` has none. The check and insert are atomic, so
+// `fn` is called at most once per missing key even under contention.
+// `fn` runs with the key's shard locked and must not use the map.
+func (s *Sync[K, V]) GetOrInsertWith(k K, fn func() V) V {
+	return s.Compute(k, func(prev option.Option[V]) option.Option[V] {
+		if prev.IsSome() {
+			return prev
+		}
+
+		return option.Some(fn())
+	}).Unwrap()
+}
+
+// Compute atomically replaces the value for `k` with the result of
+// `fn`, which is given the current value or None, and returns the new
+// value. Returning None removes the key. `fn` runs with the key's
+// shard locked and must not use the map.
+func (s *Sync[K, V]) Compute(k K, fn func(prev option.Option[V]) option.Option[V]) option.Option[V] {
+	_, next := s.compute(k, fn)
+	return next
+}
+
+func (s *Sync[K, V]) compute(k K, fn func(option.Option[V]) option.Option[V]) (prev, next option.Option[V]) {
+	sh := s.shard(k)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+
+	prev = option.None[V]()
+	if v, ok := sh.m[k]; ok {
+		prev = option.Some(v)
+	}
+
+	next = fn(prev)
+	if next.IsSome() {
+		if sh.m == nil {
+			sh.m = map[K]V{}
+		}
+
+		sh.m[k] = next.Unwrap()
+	} else {
+		delete(sh.m, k)
+	}
+
+	return prev, next
+}
+
+// Retain keeps only the entries for which `pred` returns true. Each
+// shard is locked while it is filtered, so `pred` must not use the
+// map.
+func (s *Sync[K, V]) Retain(pred func(k K, v V) bool) {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		for k, v := range sh.m {
+			if !pred(k, v) {
+				delete(sh.m, k)
+			}
+		}
+		sh.mu.Unlock()
+	}
+}
+
+// Clear removes every entry.
+func (s *Sync[K, V]) Clear() {
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.Lock()
+		clear(sh.m)
+		sh.mu.Unlock()
+	}
+}
+
+// Iter returns an Iterator over a copy of the entries as key-value
+// Pairs, in no particular order. Later writes are not reflected.
+func (s *Sync[K, V]) Iter() iter.Iterator[tuple.Pair[K, V]] {
+	var pairs []tuple.Pair[K, V]
+	for i := range s.shards {
+		sh := &s.shards[i]
+		sh.mu.RLock()
+		for k, v := range sh.m {
+			pairs = append(pairs, tuple.NewPair(k, v))
+		}
+		sh.mu.RUnlock()
+	}
+
+	return iter.FromSlice(pairs)
+}
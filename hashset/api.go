@@ -0,0 +1,164 @@
+// Package hashset is an implementation of a hash set, loosely
+// modeled on Rust's `HashSet`, with set algebra and iterators from
+// the `iter` package.
+package hashset
+
+import (
+	"github.com/jwhittle933/rs.go/iter"
+)
+
+// Set is an unordered collection of distinct `T`. The zero value is
+// an empty Set ready to use. A Set is not safe for concurrent use.
+type Set[T comparable] struct {
+	m map[T]struct{}
+}
+
+// New returns a Set holding `items`.
+func New[T comparable](items ...T) *Set[T] {
+	s := &Set[T]{m: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.m[item] = struct{}{}
+	}
+
+	return s
+}
+
+// Len returns the number of members.
+func (s *Set[T]) Len() int {
+	return len(s.m)
+}
+
+// IsEmpty reports whether the Set has no members.
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.m) == 0
+}
+
+// Insert adds `v` to the Set. Like Rust's `HashSet::insert`, it
+// returns true if `v` was newly added and false if it was already
+// a member.
+func (s *Set[T]) Insert(v T) bool {
+	if s.Contains(v) {
+		return false
+	}
+
+	if s.m == nil {
+		s.m = make(map[T]struct{})
+	}
+
+	s.m[v] = struct{}{}
+	return true
+}
+
+// Remove deletes `v` from the Set and reports whether it was a member.
+func (s *Set[T]) Remove(v T) bool {
+	if !s.Contains(v) {
+		return false
+	}
+
+	delete(s.m, v)
+	return true
+}
+
+// Contains reports whether `v` is a member.
+func (s *Set[T]) Contains(v T) bool {
+	_, ok := s.m[v]
+	return ok
+}
+
+// Union returns a new Set of the members of `s`, `other`, or both.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	out := New[T]()
+	for v := range s.m {
+		out.m[v] = struct{}{}
+	}
+
+	for v := range other.m {
+		out.m[v] = struct{}{}
+	}
+
+	return out
+}
+
+// Intersection returns a new Set of the members of both `s` and `other`.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	small, large := s, other
+	if small.Len() > large.Len() {
+		small, large = large, small
+	}
+
+	out := New[T]()
+	for v := range small.m {
+		if large.Contains(v) {
+			out.m[v] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+// Difference returns a new Set of the members of `s` that are not
+// in `other`.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	out := New[T]()
+	for v := range s.m {
+		if !other.Contains(v) {
+			out.m[v] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+// SymmetricDifference returns a new Set of the members of exactly
+// one of `s` and `other`.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	out := s.Difference(other)
+	for v := range other.m {
+		if !s.Contains(v) {
+			out.m[v] = struct{}{}
+		}
+	}
+
+	return out
+}
+
+// IsSubset reports whether every member of `s` is in `other`.
+func (s *Set[T]) IsSubset(other *Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+
+	for v := range s.m {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset reports whether every member of `other` is in `s`.
+func (s *Set[T]) IsSuperset(other *Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint reports whether `s` and `other` have no members in common.
+func (s *Set[T]) IsDisjoint(other *Set[T]) bool {
+	return s.Intersection(other).IsEmpty()
+}
+
+// Iter returns an Iterator over the members, in no particular order.
+// The members are captured when Iter is called.
+func (s *Set[T]) Iter() iter.Iterator[T] {
+	return iter.FromSlice(s.ToSlice())
+}
+
+// ToSlice returns the members as a slice, in no particular order.
+func (s *Set[T]) ToSlice() []T {
+	out := make([]T, 0, len(s.m))
+	for v := range s.m {
+		out = append(out, v)
+	}
+
+	return out
+}
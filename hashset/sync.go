@@ -0,0 +1,85 @@
+package hashset
+
+import (
+	"github.com/jwhittle933/rs.go/hashmap"
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Sync is a hash set that is safe for concurrent use, backed by a
+// `hashmap.Sync`. See it for how contention and whole-set operations
+// behave. The zero value is an empty set ready to use. A Sync must not
+// be copied after first use.
+type Sync[T comparable] struct {
+	m hashmap.Sync[T, struct{}]
+}
+
+// NewSync returns a Sync set holding `items`.
+func NewSync[T comparable](items ...T) *Sync[T] {
+	s := &Sync[T]{}
+	for _, item := range items {
+		s.Insert(item)
+	}
+
+	return s
+}
+
+// Len returns the number of members.
+func (s *Sync[T]) Len() int {
+	return s.m.Len()
+}
+
+// IsEmpty reports whether the set has no members.
+func (s *Sync[T]) IsEmpty() bool {
+	return s.m.IsEmpty()
+}
+
+// Insert adds `v` to the set, returning true if it was newly added.
+func (s *Sync[T]) Insert(v T) bool {
+	return s.m.Insert(v, struct{}{}).IsNone()
+}
+
+// Remove removes `v` from the set, returning true if it was a member.
+func (s *Sync[T]) Remove(v T) bool {
+	return s.m.Remove(v).IsSome()
+}
+
+// Contains reports whether `v` is a member.
+func (s *Sync[T]) Contains(v T) bool {
+	return s.m.ContainsKey(v)
+}
+
+// Compute atomically decides whether `v` is a member, given whether it
+// is one now, and returns the decision. `fn` runs with the member's
+// shard locked and must not use the set.
+func (s *Sync[T]) Compute(v T, fn func(present bool) bool) bool {
+	return s.m.Compute(v, func(prev option.Option[struct{}]) option.Option[struct{}] {
+		if fn(prev.IsSome()) {
+			return option.Some(struct{}{})
+		}
+
+		return option.None[struct{}]()
+	}).IsSome()
+}
+
+// Clear removes every member.
+func (s *Sync[T]) Clear() {
+	s.m.Clear()
+}
+
+// Iter returns an Iterator over a copy of the members, in no
+// particular order.
+func (s *Sync[T]) Iter() iter.Iterator[T] {
+	return iter.FromSlice(s.ToSlice())
+}
+
+// ToSlice returns the members in a new slice, in no particular order.
+func (s *Sync[T]) ToSlice() []T {
+	pairs := iter.Collect(s.m.Iter())
+	out := make([]T, len(pairs))
+	for i, p := range pairs {
+		out[i] = p.First
+	}
+
+	return out
+}
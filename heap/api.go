@@ -0,0 +1,136 @@
+// Package heap is an implementation of a priority queue, loosely
+// modeled on Rust's `BinaryHeap`. Unlike `container/heap`, it is
+// generic and needs no interface boilerplate, and its accessors
+// return `Option`s.
+//
+// A Heap pops its smallest item first, as ordered by its comparator.
+// Wrap the comparator with `cmp.Reversed` for a max-heap.
+package heap
+
+import (
+	"github.com/jwhittle933/rs.go/cmp"
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Heap is a d-ary heap of `T`, binary by default. Create one with
+// `New`, `NewOrdered`, or `NewOrd`; the zero value has no ordering and
+// must not be used. A Heap is not safe for concurrent use.
+type Heap[T any] struct {
+	items   []T
+	compare func(a, b T) int
+	arity   int
+}
+
+// New returns an empty Heap ordered by `compare`, which returns a
+// negative number, zero, or a positive number when `a` sorts before,
+// with, or after `b`.
+func New[T any](compare func(a, b T) int) *Heap[T] {
+	return &Heap[T]{compare: compare, arity: 2}
+}
+
+// NewOrdered returns an empty Heap ordered by the natural order of `T`.
+func NewOrdered[T cmp.Ordered]() *Heap[T] {
+	return New(func(a, b T) int { return cmp.Compare(a, b).Int() })
+}
+
+// NewOrd returns an empty Heap ordered by the `Cmp` method of `T`.
+func NewOrd[T cmp.Ord[T]]() *Heap[T] {
+	return New(cmp.Natural[T]())
+}
+
+// WithArity sets the number of children per node to `d` and returns
+// the Heap. Wider heaps are shallower, which speeds up `Push` at some
+// cost to `Pop`; 4 is a common choice for push-heavy workloads. Values
+// of `d` below 2 are treated as 2.
+func (h *Heap[T]) WithArity(d int) *Heap[T] {
+	h.arity = max(d, 2)
+	for i := (len(h.items) - 2) / h.arity; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return h
+}
+
+// Len returns the number of items.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
+
+// IsEmpty reports whether the Heap has no items.
+func (h *Heap[T]) IsEmpty() bool {
+	return len(h.items) == 0
+}
+
+// Push adds `v` to the Heap in O(log n).
+func (h *Heap[T]) Push(v T) {
+	h.items = append(h.items, v)
+	h.up(len(h.items) - 1)
+}
+
+// Peek returns the smallest item without removing it, or None if the
+// Heap is empty.
+func (h *Heap[T]) Peek() option.Option[T] {
+	if len(h.items) == 0 {
+		return option.None[T]()
+	}
+
+	return option.Some(h.items[0])
+}
+
+// Pop removes and returns the smallest item in O(log n), or None if
+// the Heap is empty.
+func (h *Heap[T]) Pop() option.Option[T] {
+	if len(h.items) == 0 {
+		return option.None[T]()
+	}
+
+	var zero T
+	top, last := h.items[0], len(h.items)-1
+	h.items[0] = h.items[last]
+	h.items[last] = zero
+	h.items = h.items[:last]
+	h.down(0)
+	return option.Some(top)
+}
+
+// IntoSortedSlice removes every item and returns them in ascending
+// order, leaving the Heap empty.
+func (h *Heap[T]) IntoSortedSlice() []T {
+	out := make([]T, 0, len(h.items))
+	for v := h.Pop(); v.IsSome(); v = h.Pop() {
+		out = append(out, v.Unwrap())
+	}
+
+	return out
+}
+
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / h.arity
+		if h.compare(h.items[i], h.items[parent]) >= 0 {
+			return
+		}
+
+		h.items[i], h.items[parent] = h.items[parent], h.items[i]
+		i = parent
+	}
+}
+
+func (h *Heap[T]) down(i int) {
+	for {
+		smallest := i
+		first := h.arity*i + 1
+		for c := first; c < first+h.arity && c < len(h.items); c++ {
+			if h.compare(h.items[c], h.items[smallest]) < 0 {
+				smallest = c
+			}
+		}
+
+		if smallest == i {
+			return
+		}
+
+		h.items[i], h.items[smallest] = h.items[smallest], h.items[i]
+		i = smallest
+	}
+}
@@ -0,0 +1,164 @@
+// Package httpx provides HTTP client helpers that return `Result`, so a
+// request and its status check can be written as one chain:
+//
+//	res := httpx.Get(ctx, url).AndThen(httpx.CheckStatus)
+//
+// `GetJSON` goes one step further and decodes the body.
+package httpx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// maxErrorBody bounds how much of a failed response's body is kept in
+// a `StatusError`.
+const maxErrorBody = 4 << 10
+
+// StatusError reports a response whose status code is not 2xx. `Body`
+// holds up to the first 4KiB of the response body.
+type StatusError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	Body       []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("httpx: %s %s: %s", e.Method, e.URL, e.Status)
+}
+
+// IsClientError reports whether the status code is 4xx.
+func (e *StatusError) IsClientError() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500
+}
+
+// IsServerError reports whether the status code is 5xx.
+func (e *StatusError) IsServerError() bool {
+	return e.StatusCode >= 500 && e.StatusCode < 600
+}
+
+// Client wraps an `*http.Client`. The zero value uses
+// `http.DefaultClient`.
+type Client struct {
+	HTTP *http.Client
+}
+
+// Get issues a GET request for `url`.
+func (c Client) Get(ctx context.Context, url string) result.Result[*http.Response, error] {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return result.Err[*http.Response](err)
+	}
+
+	return c.Do(ctx, req)
+}
+
+// Do sends `req` with `ctx` as its context. As with `http.Client.Do`,
+// a non-2xx response is not an error; chain `CheckStatus` for that.
+func (c Client) Do(ctx context.Context, req *http.Request) result.Result[*http.Response, error] {
+	client := c.HTTP
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return result.Match(client.Do(req.WithContext(ctx)))
+}
+
+// Get issues a GET request for `url` with `http.DefaultClient`.
+func Get(ctx context.Context, url string) result.Result[*http.Response, error] {
+	return Client{}.Get(ctx, url)
+}
+
+// Do sends `req` with `http.DefaultClient`. See `Client.Do`.
+func Do(ctx context.Context, req *http.Request) result.Result[*http.Response, error] {
+	return Client{}.Do(ctx, req)
+}
+
+// CheckStatus returns `resp` unchanged if its status code is 2xx.
+// Otherwise it reads the start of the body, closes it, and returns a
+// `*StatusError`.
+func CheckStatus(resp *http.Response) result.Result[*http.Response, error] {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return result.Ok(resp)
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+	serr := &StatusError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Body:       body,
+	}
+	if resp.Request != nil {
+		serr.Method = resp.Request.Method
+		serr.URL = resp.Request.URL.String()
+	}
+
+	return result.Err[*http.Response](error(serr))
+}
+
+// DecodeJSON decodes the body of `resp` as JSON into a `T` and closes
+// it. It does not check the status code; chain `CheckStatus` first.
+func DecodeJSON[T any](resp *http.Response) result.Result[T, error] {
+	defer resp.Body.Close()
+	var v T
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return result.Err[T](err)
+	}
+
+	return result.Ok(v)
+}
+
+// GetJSON issues a GET request for `url`, checks the status, and
+// decodes the body as JSON into a `T`.
+func GetJSON[T any](ctx context.Context, url string) result.Result[T, error] {
+	res := Get(ctx, url).AndThen(CheckStatus)
+	if res.IsErr() {
+		return result.Err[T](res.UnwrapErr())
+	}
+
+	return DecodeJSON[T](res.Unwrap())
+}
+
+// Retryable reports whether a request that failed with `err` is worth
+// retrying: a 408, 429, or 5xx status other than 501, a network
+// timeout, or a connection that was refused, reset, or cut short. A
+// cancelled or expired context is never retryable. It fits the
+// `retryIf` argument of `result.RetryIf`.
+func Retryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var serr *StatusError
+	if errors.As(err, &serr) {
+		switch serr.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		case http.StatusNotImplemented:
+			return false
+		}
+
+		return serr.IsServerError()
+	}
+
+	var nerr net.Error
+	if errors.As(err, &nerr) && nerr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
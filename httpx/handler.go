@@ -0,0 +1,165 @@
+package httpx
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/jwhittle933/rs.go/errorx"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Problem is an RFC 9457 problem details object, the body written for
+// failed requests by `RenderProblem`.
+type Problem struct {
+	Type     string         `json:"type,omitempty"`
+	Title    string         `json:"title"`
+	Status   int            `json:"status"`
+	Detail   string         `json:"detail,omitempty"`
+	Instance string         `json:"instance,omitempty"`
+	Fields   map[string]any `json:"fields,omitempty"`
+}
+
+// ErrorRenderer writes the response for a handler that returned an
+// error.
+type ErrorRenderer func(w http.ResponseWriter, r *http.Request, err error)
+
+type handlerConfig struct {
+	status int
+	render ErrorRenderer
+}
+
+// HandlerOption configures `Handler`.
+type HandlerOption func(*handlerConfig)
+
+// WithStatus sets the status code for ok responses, which defaults to
+// 200. With 204 No Content, the value is not written.
+func WithStatus(code int) HandlerOption {
+	return func(c *handlerConfig) {
+		c.status = code
+	}
+}
+
+// WithErrorRenderer replaces `RenderProblem` as the renderer for
+// errors.
+func WithErrorRenderer(render ErrorRenderer) HandlerOption {
+	return func(c *handlerConfig) {
+		c.render = render
+	}
+}
+
+// Handler adapts `fn` to an `http.Handler`. An ok value is written as
+// JSON; an error is passed to the error renderer, which by default is
+// `RenderProblem`.
+func Handler[T any](fn func(r *http.Request) result.Result[T, error], opts ...HandlerOption) http.Handler {
+	c := handlerConfig{status: http.StatusOK, render: RenderProblem}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := fn(r)
+		if res.IsErr() {
+			c.render(w, r, res.UnwrapErr())
+			return
+		}
+
+		if c.status == http.StatusNoContent {
+			w.WriteHeader(c.status)
+			return
+		}
+
+		var body bytes.Buffer
+		if err := json.NewEncoder(&body).Encode(res.Unwrap()); err != nil {
+			c.render(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(c.status)
+		w.Write(body.Bytes())
+	})
+}
+
+// StatusCode returns the HTTP status for `err`: the code of its
+// `errorx.Kind` if that is a valid HTTP status, 499 for a canceled
+// context, 504 for an expired one, and 500 otherwise.
+func StatusCode(err error) int {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return 499
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	}
+
+	if kind := errorx.KindOf(err); kind.IsSome() {
+		if code := kind.Unwrap().Code(); code >= 100 && code <= 599 {
+			return code
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
+// ProblemFor builds the `Problem` for `err`. The title is the name of
+// the error's Kind, or the status text if it has none. For 4xx
+// statuses the detail is the error message and the fields are those of
+// the error with the Kind. Server errors carry neither, so internal
+// details do not leak to clients.
+func ProblemFor(r *http.Request, err error) Problem {
+	p := Problem{Status: StatusCode(err), Instance: r.URL.Path}
+	p.Title = http.StatusText(p.Status)
+	kind := errorx.KindOf(err)
+	if kind.IsSome() {
+		p.Title = kind.Unwrap().Name()
+	}
+
+	if p.Status >= 500 {
+		return p
+	}
+
+	p.Detail = err.Error()
+	if e := kindError(err); e != nil && len(e.Fields()) > 0 {
+		p.Fields = e.Fields()
+	}
+
+	return p
+}
+
+// RenderProblem is the default `ErrorRenderer`. It writes
+// `ProblemFor(r, err)` as application/problem+json.
+func RenderProblem(w http.ResponseWriter, r *http.Request, err error) {
+	p := ProblemFor(r, err)
+	body, merr := json.Marshal(p)
+	if merr != nil {
+		p.Fields = nil
+		body, _ = json.Marshal(p)
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	w.Write(body)
+}
+
+// kindError returns the first `errorx.Error` in the chain of `err`
+// that has a Kind, the same one `errorx.KindOf` finds.
+func kindError(err error) *errorx.Error {
+	if e, ok := err.(*errorx.Error); ok && e.Kind().IsSome() {
+		return e
+	}
+
+	switch u := err.(type) {
+	case interface{ Unwrap() error }:
+		return kindError(u.Unwrap())
+	case interface{ Unwrap() []error }:
+		for _, inner := range u.Unwrap() {
+			if e := kindError(inner); e != nil {
+				return e
+			}
+		}
+	}
+
+	return nil
+}
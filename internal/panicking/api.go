@@ -0,0 +1,88 @@
+// Package panicking holds the process-wide hook that `Option` and
+// `Result` call before panicking in `Expect`, `Unwrap`, and their
+// variants. It is set through `rs.SetUnwrapHandler`.
+package panicking
+
+import (
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// Frame is a single function call in the stack passed to a handler.
+type Frame struct {
+	Function string
+	File     string
+	Line     int
+}
+
+// Handler is called with the panic message and the stack of the code
+// that called `Unwrap` or `Expect`, innermost call first.
+type Handler func(msg string, stack []Frame)
+
+var handler atomic.Pointer[Handler]
+
+// SetHandler installs `h`, replacing any previous handler. A nil `h`
+// removes the handler.
+func SetHandler(h Handler) {
+	if h == nil {
+		handler.Store(nil)
+		return
+	}
+
+	handler.Store(&h)
+}
+
+// internal lists the packages whose frames are dropped from the top of
+// the stack, so that it starts at the caller of `Unwrap` or `Expect`.
+var internal = []string{
+	"github.com/jwhittle933/rs.go/internal/panicking.",
+	"github.com/jwhittle933/rs.go/option.",
+	"github.com/jwhittle933/rs.go/result.",
+}
+
+// Panic calls the installed handler, if any, and then panics with
+// `msg`. The handler may exit the process or panic itself instead.
+func Panic(msg string) {
+	if h := handler.Load(); h != nil {
+		(*h)(msg, stack())
+	}
+
+	panic(msg)
+}
+
+func stack() []Frame {
+	pcs := make([]uintptr, 64)
+	pcs = pcs[:runtime.Callers(2, pcs)]
+	frames := runtime.CallersFrames(pcs)
+
+	var out []Frame
+	top := true
+	for {
+		f, more := frames.Next()
+		if top && isInternal(f.Function) {
+			if !more {
+				break
+			}
+			continue
+		}
+
+		top = false
+		out = append(out, Frame{Function: f.Function, File: f.File, Line: f.Line})
+		if !more {
+			break
+		}
+	}
+
+	return out
+}
+
+func isInternal(fn string) bool {
+	for _, prefix := range internal {
+		if strings.HasPrefix(fn, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
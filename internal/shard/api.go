@@ -0,0 +1,15 @@
+// Package shard picks the lock shard for a key in the concurrent
+// collections of `hashmap` and `hashset`.
+package shard
+
+import "hash/maphash"
+
+// Count is the number of shards in each concurrent collection. It is
+// a power of two so a shard can be picked with a mask.
+const Count = 64
+
+// Index returns the shard for `k`. Equal keys always get the same
+// shard; `seed` varies the assignment between collections.
+func Index[K comparable](seed maphash.Seed, k K) int {
+	return int(hash(seed, k) & (Count - 1))
+}
@@ -0,0 +1,48 @@
+//go:build !go1.24
+
+package shard
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+)
+
+// hash covers strings and integers, the common key types. Before Go
+// 1.24 there is no general way to hash a comparable value, and
+// hashing its printed form would split keys such as 0.0 and -0.0 that
+// compare equal, so every other key type shares shard 0.
+func hash[K comparable](seed maphash.Seed, k K) uint64 {
+	var n uint64
+	switch v := any(k).(type) {
+	case string:
+		return maphash.String(seed, v)
+	case int:
+		n = uint64(v)
+	case int8:
+		n = uint64(v)
+	case int16:
+		n = uint64(v)
+	case int32:
+		n = uint64(v)
+	case int64:
+		n = uint64(v)
+	case uint:
+		n = uint64(v)
+	case uint8:
+		n = uint64(v)
+	case uint16:
+		n = uint64(v)
+	case uint32:
+		n = uint64(v)
+	case uint64:
+		n = v
+	case uintptr:
+		n = uint64(v)
+	default:
+		return 0
+	}
+
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], n)
+	return maphash.Bytes(seed, buf[:])
+}
@@ -0,0 +1,9 @@
+//go:build go1.24
+
+package shard
+
+import "hash/maphash"
+
+func hash[K comparable](seed maphash.Seed, k K) uint64 {
+	return maphash.Comparable(seed, k)
+}
@@ -0,0 +1,75 @@
+// Package iox wraps `io` helpers so that they return `Result`, and
+// provides scopes that close a resource however its use ends.
+package iox
+
+import (
+	"io"
+
+	"github.com/jwhittle933/rs.go/errorx"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// ReadAll reads from `r` until EOF, as `io.ReadAll` does.
+func ReadAll(r io.Reader) result.Result[[]byte, error] {
+	return result.Match(io.ReadAll(r))
+}
+
+// Copy copies from `src` to `dst` until EOF, as `io.Copy` does, and
+// returns the number of bytes copied.
+func Copy(dst io.Writer, src io.Reader) result.Result[int64, error] {
+	return result.Match(io.Copy(dst, src))
+}
+
+// CopyN copies exactly `n` bytes from `src` to `dst`, as `io.CopyN`
+// does. Copying fewer bytes is an error.
+func CopyN(dst io.Writer, src io.Reader, n int64) result.Result[int64, error] {
+	return result.Match(io.CopyN(dst, src, n))
+}
+
+// WithCloser opens a resource with `open`, passes it to `use`, and
+// closes it before returning, even if `use` panics. If both `use` and
+// `Close` fail, the errors are joined with `errorx.Join`. If `open`
+// fails, `use` is not called and the open error is returned.
+func WithCloser[T any](
+	open func() (io.ReadCloser, error),
+	use func(r io.Reader) result.Result[T, error],
+) result.Result[T, error] {
+	rc, err := open()
+	if err != nil {
+		return result.Err[T](err)
+	}
+
+	return scope(rc, func() result.Result[T, error] { return use(rc) })
+}
+
+// WithWriteCloser is `WithCloser` for resources that are written to,
+// where a failed `Close` often means buffered data was lost.
+func WithWriteCloser[T any](
+	open func() (io.WriteCloser, error),
+	use func(w io.Writer) result.Result[T, error],
+) result.Result[T, error] {
+	wc, err := open()
+	if err != nil {
+		return result.Err[T](err)
+	}
+
+	return scope(wc, func() result.Result[T, error] { return use(wc) })
+}
+
+func scope[T any](c io.Closer, use func() result.Result[T, error]) (res result.Result[T, error]) {
+	defer func() {
+		cerr := c.Close()
+		if cerr == nil {
+			return
+		}
+
+		if res.IsErr() {
+			res = result.Err[T](errorx.Join(res.UnwrapErr(), cerr))
+			return
+		}
+
+		res = result.Err[T](cerr)
+	}()
+
+	return use()
+}
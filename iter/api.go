@@ -0,0 +1,50 @@
+// Package iter is an implementation of lazy iterators,
+// loosely modeled on Rust's `Iterator`.
+package iter
+
+import (
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Iterator produces a sequence of values, one at a time. Next
+// returns `Some` with the next value, or `None` once the sequence
+// is exhausted. An exhausted Iterator keeps returning `None`.
+type Iterator[T any] interface {
+	Next() option.Option[T]
+}
+
+// Func adapts an ordinary function to the Iterator interface.
+type Func[T any] func() option.Option[T]
+
+// Next calls `f`.
+func (f Func[T]) Next() option.Option[T] {
+	return f()
+}
+
+// Empty returns an Iterator that yields nothing.
+func Empty[T any]() Iterator[T] {
+	return Func[T](option.None[T])
+}
+
+// FromSlice returns an Iterator over the elements of `s`.
+func FromSlice[T any](s []T) Iterator[T] {
+	i := 0
+	return Func[T](func() option.Option[T] {
+		if i >= len(s) {
+			return option.None[T]()
+		}
+
+		i++
+		return option.Some(s[i-1])
+	})
+}
+
+// Collect drains `it` into a slice.
+func Collect[T any](it Iterator[T]) []T {
+	var out []T
+	for next := it.Next(); next.IsSome(); next = it.Next() {
+		out = append(out, next.Unwrap())
+	}
+
+	return out
+}
@@ -0,0 +1,47 @@
+package iter
+
+import (
+	"time"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Stats is the progress of an Iterator wrapped by `Counted`.
+type Stats struct {
+	// Count is the number of values yielded so far.
+	Count int
+	// Elapsed is the time since the first call to Next.
+	Elapsed time.Duration
+	// Done reports whether the underlying Iterator is exhausted.
+	Done bool
+}
+
+// Counted returns an Iterator over the values of `it` that calls
+// `report` with the running Stats after each value, and once more
+// with `Done` set when `it` is exhausted. `report` runs on the
+// goroutine calling Next, so it should be quick; throttle inside it
+// if reporting every value is too often.
+func Counted[T any](it Iterator[T], report func(Stats)) Iterator[T] {
+	var stats Stats
+	var start time.Time
+	return Func[T](func() option.Option[T] {
+		if stats.Done {
+			return option.None[T]()
+		}
+
+		if start.IsZero() {
+			start = time.Now()
+		}
+
+		next := it.Next()
+		if next.IsSome() {
+			stats.Count++
+		} else {
+			stats.Done = true
+		}
+
+		stats.Elapsed = time.Since(start)
+		report(stats)
+		return next
+	})
+}
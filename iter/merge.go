@@ -0,0 +1,86 @@
+package iter
+
+import (
+	"github.com/jwhittle933/rs.go/heap"
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Merge returns an Iterator over the elements of `a` and `b` in the
+// order given by `compare`, assuming each is already sorted by it.
+// Elements are pulled only as they are needed. Equal elements are
+// yielded from `a` before `b`, so merging is stable.
+func Merge[T any](a, b Iterator[T], compare func(x, y T) int) Iterator[T] {
+	var heads [2]option.Option[T]
+	started := false
+
+	return Func[T](func() option.Option[T] {
+		if !started {
+			heads[0], heads[1] = a.Next(), b.Next()
+			started = true
+		}
+
+		pick := 0
+		switch {
+		case heads[0].IsNone():
+			pick = 1
+		case heads[1].IsSome() && compare(heads[1].Unwrap(), heads[0].Unwrap()) < 0:
+			pick = 1
+		}
+
+		out := heads[pick]
+		if out.IsSome() {
+			if pick == 0 {
+				heads[0] = a.Next()
+			} else {
+				heads[1] = b.Next()
+			}
+		}
+
+		return out
+	})
+}
+
+// source is the next element of one input to `KWayMerge`.
+type source[T any] struct {
+	value T
+	index int
+}
+
+// KWayMerge returns an Iterator over the elements of every iterator in
+// `its` in the order given by `compare`, assuming each is already
+// sorted by it. It holds one element per input in a heap, so each step
+// costs O(log k) for k inputs. Equal elements are yielded in the order
+// of their inputs in `its`, so merging is stable.
+func KWayMerge[T any](its []Iterator[T], compare func(x, y T) int) Iterator[T] {
+	h := heap.New(func(x, y source[T]) int {
+		if c := compare(x.value, y.value); c != 0 {
+			return c
+		}
+
+		return x.index - y.index
+	})
+	started := false
+
+	return Func[T](func() option.Option[T] {
+		if !started {
+			for i, it := range its {
+				if v := it.Next(); v.IsSome() {
+					h.Push(source[T]{value: v.Unwrap(), index: i})
+				}
+			}
+			started = true
+		}
+
+		top := h.Pop()
+		if top.IsNone() {
+			return option.None[T]()
+		}
+
+		s := top.Unwrap()
+		if v := its[s.index].Next(); v.IsSome() {
+			h.Push(source[T]{value: v.Unwrap(), index: s.index})
+		}
+
+		return option.Some(s.value)
+	})
+}
@@ -0,0 +1,113 @@
+// Package jsonx provides generic `encoding/json` helpers that return
+// `Result`, with options for strict decoding.
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// ErrTrailingData is returned when a strict decode finds more input
+// after the first JSON value.
+var ErrTrailingData = errors.New("jsonx: unexpected data after top-level value")
+
+type config struct {
+	disallowUnknown bool
+	disallowTrail   bool
+	useNumber       bool
+}
+
+// DecodeOption configures `Decode` and `Unmarshal`.
+type DecodeOption func(*config)
+
+// DisallowUnknownFields makes it an error for an object to contain a
+// key that does not match an exported field of the destination struct.
+func DisallowUnknownFields() DecodeOption {
+	return func(c *config) {
+		c.disallowUnknown = true
+	}
+}
+
+// DisallowTrailingData makes `Decode` fail if anything other than
+// whitespace follows the first JSON value. `Unmarshal` always does so.
+func DisallowTrailingData() DecodeOption {
+	return func(c *config) {
+		c.disallowTrail = true
+	}
+}
+
+// UseNumber decodes numbers into `any` values as `json.Number` rather
+// than `float64`.
+func UseNumber() DecodeOption {
+	return func(c *config) {
+		c.useNumber = true
+	}
+}
+
+// Strict combines `DisallowUnknownFields` and `DisallowTrailingData`.
+func Strict() DecodeOption {
+	return func(c *config) {
+		c.disallowUnknown = true
+		c.disallowTrail = true
+	}
+}
+
+// Decode reads one JSON value from `r` into a `T`.
+func Decode[T any](r io.Reader, opts ...DecodeOption) result.Result[T, error] {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return decode[T](r, c)
+}
+
+// Unmarshal parses `data` as a single JSON value into a `T`. Like
+// `json.Unmarshal`, it rejects trailing data.
+func Unmarshal[T any](data []byte, opts ...DecodeOption) result.Result[T, error] {
+	c := config{disallowTrail: true}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return decode[T](bytes.NewReader(data), c)
+}
+
+func decode[T any](r io.Reader, c config) result.Result[T, error] {
+	dec := json.NewDecoder(r)
+	if c.disallowUnknown {
+		dec.DisallowUnknownFields()
+	}
+
+	if c.useNumber {
+		dec.UseNumber()
+	}
+
+	var v T
+	if err := dec.Decode(&v); err != nil {
+		return result.Err[T](err)
+	}
+
+	if c.disallowTrail {
+		if _, err := dec.Token(); err != io.EOF {
+			return result.Err[T](ErrTrailingData)
+		}
+	}
+
+	return result.Ok(v)
+}
+
+// Marshal encodes `v` as JSON.
+func Marshal(v any) result.Result[[]byte, error] {
+	return result.Match(json.Marshal(v))
+}
+
+// MarshalIndent encodes `v` as indented JSON. See
+// `json.MarshalIndent`.
+func MarshalIndent(v any, prefix, indent string) result.Result[[]byte, error] {
+	return result.Match(json.MarshalIndent(v, prefix, indent))
+}
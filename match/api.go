@@ -0,0 +1,70 @@
+// Package match is an implementation of expression-style pattern
+// matching, loosely modeled on Rust's `match`. Go's `switch` is a
+// statement; a Matcher produces a value.
+//
+// Go cannot infer the output type of a match from the value being
+// matched, so it must be given explicitly:
+//
+//	label := match.Value[int, string](n).
+//		WhenEq(0, func(int) string { return "zero" }).
+//		When(isEven, func(int) string { return "even" }).
+//		Default(func(int) string { return "odd" }).
+//		Eval().
+//		Unwrap()
+package match
+
+import (
+	"reflect"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+type arm[T any, R any] struct {
+	pred func(T) bool
+	fn   func(T) R
+}
+
+// Matcher matches a value of type `T` against a list of arms and
+// evaluates the first one that matches to produce an `R`.
+type Matcher[T any, R any] struct {
+	value T
+	arms  []arm[T, R]
+}
+
+// Value starts a match on `x`.
+func Value[T any, R any](x T) *Matcher[T, R] {
+	return &Matcher[T, R]{value: x}
+}
+
+// When adds an arm that matches when `pred` returns true.
+func (m *Matcher[T, R]) When(pred func(T) bool, fn func(T) R) *Matcher[T, R] {
+	m.arms = append(m.arms, arm[T, R]{pred: pred, fn: fn})
+	return m
+}
+
+// WhenGuard adds an arm that matches when both `pred` and `guard`
+// return true. `guard` is only called when `pred` matches, so it may
+// rely on what `pred` established.
+func (m *Matcher[T, R]) WhenGuard(pred func(T) bool, guard func(T) bool, fn func(T) R) *Matcher[T, R] {
+	return m.When(func(x T) bool { return pred(x) && guard(x) }, fn)
+}
+
+// WhenEq adds an arm that matches when the value equals `v`.
+// Without further constraining T, values are compared with
+// `reflect.DeepEqual`.
+func (m *Matcher[T, R]) WhenEq(v T, fn func(T) R) *Matcher[T, R] {
+	return m.When(func(x T) bool { return reflect.DeepEqual(x, v) }, fn)
+}
+
+// Default adds an arm that always matches. Arms added after it
+// are never reached.
+func (m *Matcher[T, R]) Default(fn func(T) R) *Matcher[T, R] {
+	return m.When(func(T) bool { return true }, fn)
+}
+
+// Eval tries each arm in the order it was added and returns the
+// output of the first that matches, or None if none match. Only the
+// matching arm's function is called.
+func (m *Matcher[T, R]) Eval() option.Option[R] {
+	return evalArms(m.arms, m.value)
+}
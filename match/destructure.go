@@ -0,0 +1,125 @@
+package match
+
+import (
+	"github.com/jwhittle933/rs.go/either"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/tuple"
+)
+
+type pairArm[A any, B any, R any] struct {
+	pred func(A, B) bool
+	fn   func(A, B) R
+}
+
+// PairMatcher matches on a `tuple.Pair`, passing its values to
+// predicates and arms as separate arguments.
+type PairMatcher[A any, B any, R any] struct {
+	p    tuple.Pair[A, B]
+	arms []pairArm[A, B, R]
+}
+
+// OnPair starts a match on `p`.
+func OnPair[A any, B any, R any](p tuple.Pair[A, B]) *PairMatcher[A, B, R] {
+	return &PairMatcher[A, B, R]{p: p}
+}
+
+// When adds an arm that matches when `pred` returns true.
+func (m *PairMatcher[A, B, R]) When(pred func(A, B) bool, fn func(A, B) R) *PairMatcher[A, B, R] {
+	m.arms = append(m.arms, pairArm[A, B, R]{pred: pred, fn: fn})
+	return m
+}
+
+// WhenGuard adds an arm that matches when both `pred` and `guard`
+// return true. `guard` is only called when `pred` matches.
+func (m *PairMatcher[A, B, R]) WhenGuard(pred func(A, B) bool, guard func(A, B) bool, fn func(A, B) R) *PairMatcher[A, B, R] {
+	return m.When(func(a A, b B) bool { return pred(a, b) && guard(a, b) }, fn)
+}
+
+// Default adds an arm that always matches.
+func (m *PairMatcher[A, B, R]) Default(fn func(A, B) R) *PairMatcher[A, B, R] {
+	return m.When(func(A, B) bool { return true }, fn)
+}
+
+// Eval returns the output of the first matching arm, or None if
+// none match.
+func (m *PairMatcher[A, B, R]) Eval() option.Option[R] {
+	for _, a := range m.arms {
+		if a.pred(m.p.First, m.p.Second) {
+			return option.Some(a.fn(m.p.First, m.p.Second))
+		}
+	}
+
+	return option.None[R]()
+}
+
+// Either evaluates `leftArm` or `rightArm` depending on which value
+// `e` holds. Both arms are required, so the match is exhaustive. It
+// panics if `e` holds neither, which only happens for a zero-value Either.
+func Either[L any, Rt any, R any](e either.Either[L, Rt], leftArm func(L) R, rightArm func(Rt) R) R {
+	if e.IsLeft() {
+		return leftArm(e.Left().Unwrap())
+	}
+
+	return rightArm(e.Right().Unwrap())
+}
+
+// EitherMatcher matches on the sides of an `either.Either`, with
+// optional guards on each arm.
+type EitherMatcher[L any, Rt any, R any] struct {
+	e     either.Either[L, Rt]
+	left  []arm[L, R]
+	right []arm[Rt, R]
+}
+
+// OnEither starts a match on `e`.
+func OnEither[L any, Rt any, R any](e either.Either[L, Rt]) *EitherMatcher[L, Rt, R] {
+	return &EitherMatcher[L, Rt, R]{e: e}
+}
+
+// Left adds an arm that matches any left value.
+func (m *EitherMatcher[L, Rt, R]) Left(fn func(L) R) *EitherMatcher[L, Rt, R] {
+	return m.LeftWhen(func(L) bool { return true }, fn)
+}
+
+// LeftWhen adds an arm that matches a left value for which `guard`
+// returns true.
+func (m *EitherMatcher[L, Rt, R]) LeftWhen(guard func(L) bool, fn func(L) R) *EitherMatcher[L, Rt, R] {
+	m.left = append(m.left, arm[L, R]{pred: guard, fn: fn})
+	return m
+}
+
+// Right adds an arm that matches any right value.
+func (m *EitherMatcher[L, Rt, R]) Right(fn func(Rt) R) *EitherMatcher[L, Rt, R] {
+	return m.RightWhen(func(Rt) bool { return true }, fn)
+}
+
+// RightWhen adds an arm that matches a right value for which `guard`
+// returns true.
+func (m *EitherMatcher[L, Rt, R]) RightWhen(guard func(Rt) bool, fn func(Rt) R) *EitherMatcher[L, Rt, R] {
+	m.right = append(m.right, arm[Rt, R]{pred: guard, fn: fn})
+	return m
+}
+
+// Eval returns the output of the first matching arm for the side
+// `e` holds, or None if none match.
+func (m *EitherMatcher[L, Rt, R]) Eval() option.Option[R] {
+	if m.e.IsLeft() {
+		return evalArms(m.left, m.e.Left().Unwrap())
+	}
+
+	if m.e.IsRight() {
+		return evalArms(m.right, m.e.Right().Unwrap())
+	}
+
+	return option.None[R]()
+}
+
+func evalArms[T any, R any](arms []arm[T, R], v T) option.Option[R] {
+	for _, a := range arms {
+		if a.pred(v) {
+			return option.Some(a.fn(v))
+		}
+	}
+
+	return option.None[R]()
+}
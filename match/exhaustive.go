@@ -0,0 +1,30 @@
+package match
+
+import (
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Result evaluates `okArm` with the wrapped data if `r` is ok, or
+// `errArm` with the wrapped error if it is not. Both arms are required,
+// so the compiler guarantees the match is exhaustive. Unlike the
+// builders, Result always produces a value. It panics if `r` is neither
+// ok nor an error, which only happens for a zero-value Result.
+func Result[T any, E any, R any](r result.Result[T, E], okArm func(T) R, errArm func(E) R) R {
+	if r.IsOk() {
+		return okArm(r.Unwrap())
+	}
+
+	return errArm(r.UnwrapErr())
+}
+
+// Option evaluates `someArm` with the wrapped value if `o` is Some,
+// or `noneArm` if it is None. Both arms are required, so the compiler
+// guarantees the match is exhaustive.
+func Option[T any, R any](o option.Option[T], someArm func(T) R, noneArm func() R) R {
+	if o.IsSome() {
+		return someArm(o.Unwrap())
+	}
+
+	return noneArm()
+}
@@ -0,0 +1,86 @@
+package match
+
+import (
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// OptionMatcher matches on the Some and None arms of an Option.
+type OptionMatcher[T any, R any] struct {
+	o    option.Option[T]
+	some option.Option[func(T) R]
+	none option.Option[func() R]
+}
+
+// OnOption starts a match on `o`.
+func OnOption[T any, R any](o option.Option[T]) *OptionMatcher[T, R] {
+	return &OptionMatcher[T, R]{o: o}
+}
+
+// Some sets the arm evaluated with the wrapped value when `o` is Some.
+func (m *OptionMatcher[T, R]) Some(fn func(T) R) *OptionMatcher[T, R] {
+	m.some = option.Some(fn)
+	return m
+}
+
+// None sets the arm evaluated when `o` is None.
+func (m *OptionMatcher[T, R]) None(fn func() R) *OptionMatcher[T, R] {
+	m.none = option.Some(fn)
+	return m
+}
+
+// Eval evaluates the arm for the Option's variant, or returns None
+// if that arm was not set.
+func (m *OptionMatcher[T, R]) Eval() option.Option[R] {
+	if m.o.IsSome() {
+		if m.some.IsSome() {
+			return option.Some(m.some.Unwrap()(m.o.Unwrap()))
+		}
+
+		return option.None[R]()
+	}
+
+	if m.none.IsSome() {
+		return option.Some(m.none.Unwrap()())
+	}
+
+	return option.None[R]()
+}
+
+// ResultMatcher matches on the Ok and Err arms of a Result.
+type ResultMatcher[T any, E any, R any] struct {
+	r   result.Result[T, E]
+	ok  option.Option[func(T) R]
+	err option.Option[func(E) R]
+}
+
+// OnResult starts a match on `r`.
+func OnResult[T any, E any, R any](r result.Result[T, E]) *ResultMatcher[T, E, R] {
+	return &ResultMatcher[T, E, R]{r: r}
+}
+
+// Ok sets the arm evaluated with the wrapped data when `r` is ok.
+func (m *ResultMatcher[T, E, R]) Ok(fn func(T) R) *ResultMatcher[T, E, R] {
+	m.ok = option.Some(fn)
+	return m
+}
+
+// Err sets the arm evaluated with the wrapped error when `r` is an error.
+func (m *ResultMatcher[T, E, R]) Err(fn func(E) R) *ResultMatcher[T, E, R] {
+	m.err = option.Some(fn)
+	return m
+}
+
+// Eval evaluates the arm for the Result's variant, or returns None
+// if that arm was not set.
+func (m *ResultMatcher[T, E, R]) Eval() option.Option[R] {
+	if m.r.IsOk() && m.ok.IsSome() {
+		return option.Some(m.ok.Unwrap()(m.r.Unwrap()))
+	}
+
+	if m.r.IsErr() && m.err.IsSome() {
+		return option.Some(m.err.Unwrap()(m.r.UnwrapErr()))
+	}
+
+	return option.None[R]()
+}
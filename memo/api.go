@@ -0,0 +1,225 @@
+// Package memo memoizes fallible functions. A memoized function caches
+// each key's outcome, optionally for a limited time, and runs the
+// underlying function at most once at a time per key: concurrent
+// callers that miss on the same key wait for a single call and share
+// its outcome.
+package memo
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// CachedError wraps an error that was served from the cache rather
+// than returned by a fresh call. `At` is when the failing call
+// finished. Use `errors.As` to tell the two apart, or `errors.Is` and
+// `errors.As` on the wrapped error as usual.
+type CachedError struct {
+	Err error
+	At  time.Time
+}
+
+func (e *CachedError) Error() string {
+	return fmt.Sprintf("memo: cached error from %s: %v", e.At.Format(time.RFC3339), e.Err)
+}
+
+func (e *CachedError) Unwrap() error {
+	return e.Err
+}
+
+type config struct {
+	ttl       time.Duration
+	errTTL    time.Duration
+	cacheErrs bool
+}
+
+// FuncOption configures `Func`.
+type FuncOption func(*config)
+
+// WithTTL expires cached values `d` after they were computed. A `d` of
+// zero or less, the default, keeps them until they are forgotten.
+func WithTTL(d time.Duration) FuncOption {
+	return func(c *config) {
+		c.ttl = d
+	}
+}
+
+// WithErrorTTL expires cached errors `d` after they were returned,
+// instead of after the value TTL. A short error TTL suits transient
+// failures.
+func WithErrorTTL(d time.Duration) FuncOption {
+	return func(c *config) {
+		c.errTTL = d
+	}
+}
+
+// NoErrorCaching makes every error uncached, so a key that failed is
+// retried on its next call.
+func NoErrorCaching() FuncOption {
+	return func(c *config) {
+		c.cacheErrs = false
+	}
+}
+
+type entry[V any] struct {
+	res     result.Result[V, error]
+	at      time.Time
+	expires time.Time // zero means never
+}
+
+func (e *entry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+type call[V any] struct {
+	wg  sync.WaitGroup
+	res result.Result[V, error]
+}
+
+// Memo is a memoized function. It is safe for concurrent use.
+type Memo[K comparable, V any] struct {
+	fn       func(K) (V, error)
+	cfg      config
+	mu       sync.Mutex
+	cache    map[K]*entry[V]
+	inflight map[K]*call[V]
+}
+
+// Func memoizes `fn`. By default both values and errors are cached
+// with no expiry.
+func Func[K comparable, V any](fn func(K) (V, error), opts ...FuncOption) *Memo[K, V] {
+	cfg := config{cacheErrs: true, errTTL: -1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.errTTL < 0 {
+		cfg.errTTL = cfg.ttl
+	}
+
+	return &Memo[K, V]{
+		fn:       fn,
+		cfg:      cfg,
+		cache:    map[K]*entry[V]{},
+		inflight: map[K]*call[V]{},
+	}
+}
+
+// Get returns the outcome for `k`, calling the function on a miss. An
+// error served from the cache is wrapped in a `*CachedError`; an error
+// from a fresh call, including one shared with concurrent callers, is
+// returned as is.
+//
+// If the function panics, the panic is passed on to the caller that
+// made the call, concurrent callers waiting on it get an error holding
+// a `*result.PanicError`, and nothing is cached.
+func (m *Memo[K, V]) Get(k K) result.Result[V, error] {
+	m.mu.Lock()
+	if e, ok := m.cache[k]; ok {
+		if !e.expired(time.Now()) {
+			m.mu.Unlock()
+			if e.res.IsErr() {
+				return result.Err[V](error(&CachedError{Err: e.res.UnwrapErr(), At: e.at}))
+			}
+
+			return e.res
+		}
+		delete(m.cache, k)
+	}
+
+	if c, ok := m.inflight[k]; ok {
+		m.mu.Unlock()
+		c.wg.Wait()
+
+		return c.res
+	}
+
+	c := &call[V]{}
+	c.wg.Add(1)
+	m.inflight[k] = c
+	m.mu.Unlock()
+
+	defer func() {
+		m.mu.Lock()
+		delete(m.inflight, k)
+		m.mu.Unlock()
+		c.wg.Done()
+	}()
+
+	// Runs before the cleanup above, so waiters see the error.
+	defer func() {
+		if v := recover(); v != nil {
+			c.res = result.Err[V](error(&result.PanicError{Value: v, Stack: debug.Stack()}))
+			panic(v)
+		}
+	}()
+
+	c.res = result.Match(m.fn(k))
+	m.store(k, c.res)
+
+	return c.res
+}
+
+func (m *Memo[K, V]) store(k K, res result.Result[V, error]) {
+	if res.IsErr() && !m.cfg.cacheErrs {
+		return
+	}
+
+	ttl := m.cfg.ttl
+	if res.IsErr() {
+		ttl = m.cfg.errTTL
+	}
+
+	now := time.Now()
+	e := &entry[V]{res: res, at: now}
+	if ttl > 0 {
+		e.expires = now.Add(ttl)
+	}
+
+	m.mu.Lock()
+	m.cache[k] = e
+	m.mu.Unlock()
+}
+
+// Peek returns the cached value for `k` without calling the function,
+// or `None` if there is no unexpired value. A cached error reads as
+// `None`.
+func (m *Memo[K, V]) Peek(k K) option.Option[V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if e, ok := m.cache[k]; ok && !e.expired(time.Now()) {
+		return e.res.Ok()
+	}
+
+	return option.None[V]()
+}
+
+// Forget removes the cached outcome for `k`, so the next `Get` calls
+// the function. A call already in flight is not affected.
+func (m *Memo[K, V]) Forget(k K) {
+	m.mu.Lock()
+	delete(m.cache, k)
+	m.mu.Unlock()
+}
+
+// Clear removes every cached outcome.
+func (m *Memo[K, V]) Clear() {
+	m.mu.Lock()
+	clear(m.cache)
+	m.mu.Unlock()
+}
+
+// Len returns the number of cached outcomes, including expired ones
+// that have not yet been evicted by a `Get`.
+func (m *Memo[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.cache)
+}
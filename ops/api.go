@@ -0,0 +1,133 @@
+// Package ops is an implementation of explicit integer overflow
+// handling, loosely modeled on Rust's `checked_*` family. Go's integer
+// arithmetic wraps silently; the functions here return None instead
+// when the mathematically correct result does not fit.
+package ops
+
+import (
+	"unsafe"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Signed is a constraint permitting any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a constraint permitting any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a constraint permitting any integer type.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// CheckedAdd returns `a + b`, or None if it overflows.
+func CheckedAdd[T Integer](a, b T) option.Option[T] {
+	c := a + b
+	if (b > 0 && c < a) || (b < 0 && c > a) {
+		return option.None[T]()
+	}
+
+	return option.Some(c)
+}
+
+// CheckedSub returns `a - b`, or None if it overflows.
+func CheckedSub[T Integer](a, b T) option.Option[T] {
+	c := a - b
+	if (b > 0 && c > a) || (b < 0 && c < a) {
+		return option.None[T]()
+	}
+
+	return option.Some(c)
+}
+
+// CheckedMul returns `a * b`, or None if it overflows.
+func CheckedMul[T Integer](a, b T) option.Option[T] {
+	if a == 0 || b == 0 {
+		return option.Some(T(0))
+	}
+
+	if isSigned[T]() && ((a == minusOne[T]() && b == MinOf[T]()) || (b == minusOne[T]() && a == MinOf[T]())) {
+		return option.None[T]()
+	}
+
+	c := a * b
+	if c/b != a {
+		return option.None[T]()
+	}
+
+	return option.Some(c)
+}
+
+// CheckedDiv returns `a / b`, or None if `b` is zero or the division
+// overflows, which happens only for the minimum signed value divided
+// by -1.
+func CheckedDiv[T Integer](a, b T) option.Option[T] {
+	if b == 0 || (isSigned[T]() && a == MinOf[T]() && b == minusOne[T]()) {
+		return option.None[T]()
+	}
+
+	return option.Some(a / b)
+}
+
+// CheckedRem returns `a % b`, or None if `b` is zero or the matching
+// division overflows.
+func CheckedRem[T Integer](a, b T) option.Option[T] {
+	if b == 0 || (isSigned[T]() && a == MinOf[T]() && b == minusOne[T]()) {
+		return option.None[T]()
+	}
+
+	return option.Some(a % b)
+}
+
+// CheckedNeg returns `-a`, or None if it overflows. For signed types
+// that is only the minimum value; for unsigned types it is anything
+// but zero.
+func CheckedNeg[T Integer](a T) option.Option[T] {
+	if isSigned[T]() {
+		if a == MinOf[T]() {
+			return option.None[T]()
+		}
+
+		return option.Some(-a)
+	}
+
+	if a != 0 {
+		return option.None[T]()
+	}
+
+	return option.Some(a)
+}
+
+// isSigned reports whether `T` is a signed integer type.
+func isSigned[T Integer]() bool {
+	return ^T(0) < 0
+}
+
+// minusOne returns -1 as a `T`. It is only meaningful for signed types.
+func minusOne[T Integer]() T {
+	return ^T(0)
+}
+
+// MaxOf returns the largest value representable by `T`.
+func MaxOf[T Integer]() T {
+	if isSigned[T]() {
+		return ^MinOf[T]()
+	}
+
+	return ^T(0)
+}
+
+// MinOf returns the smallest value representable by `T`.
+func MinOf[T Integer]() T {
+	if !isSigned[T]() {
+		return 0
+	}
+
+	var zero T
+	return T(1) << (unsafe.Sizeof(zero)*8 - 1)
+}
@@ -0,0 +1,57 @@
+package ops
+
+// SaturatingAdd returns `a + b`, clamped to the range of `T`.
+func SaturatingAdd[T Integer](a, b T) T {
+	if c := CheckedAdd(a, b); c.IsSome() {
+		return c.Unwrap()
+	}
+
+	if b > 0 {
+		return MaxOf[T]()
+	}
+
+	return MinOf[T]()
+}
+
+// SaturatingSub returns `a - b`, clamped to the range of `T`.
+func SaturatingSub[T Integer](a, b T) T {
+	if c := CheckedSub(a, b); c.IsSome() {
+		return c.Unwrap()
+	}
+
+	if b > 0 {
+		return MinOf[T]()
+	}
+
+	return MaxOf[T]()
+}
+
+// SaturatingMul returns `a * b`, clamped to the range of `T`.
+func SaturatingMul[T Integer](a, b T) T {
+	if c := CheckedMul(a, b); c.IsSome() {
+		return c.Unwrap()
+	}
+
+	if (a < 0) != (b < 0) {
+		return MinOf[T]()
+	}
+
+	return MaxOf[T]()
+}
+
+// WrappingAdd returns `a + b`, wrapping around at the boundary of `T`.
+// This is Go's native behavior; the function exists to make the intent
+// explicit at the call site.
+func WrappingAdd[T Integer](a, b T) T {
+	return a + b
+}
+
+// WrappingSub returns `a - b`, wrapping around at the boundary of `T`.
+func WrappingSub[T Integer](a, b T) T {
+	return a - b
+}
+
+// WrappingMul returns `a * b`, wrapping around at the boundary of `T`.
+func WrappingMul[T Integer](a, b T) T {
+	return a * b
+}
@@ -2,8 +2,16 @@
 // loosely modeled on Rust's `Option`.
 package option
 
+import (
+	"github.com/jwhittle933/rs.go/defaults"
+	"github.com/jwhittle933/rs.go/internal/panicking"
+)
+
+// Option holds its value inline rather than behind a pointer, so that
+// wrapping a value does not allocate.
 type Option[T any] struct {
-	some *T
+	value T
+	some  bool
 }
 
 func (o Option[T]) And(other Option[T]) Option[T] {
@@ -16,26 +24,26 @@ func (o Option[T]) And(other Option[T]) Option[T] {
 
 func (o Option[T]) AndThen(fn func(data T) Option[T]) Option[T] {
 	if o.IsSome() {
-		return fn(*o.some)
+		return fn(o.value)
 	}
 
 	return o
 }
 
 func (o Option[T]) IsSome() bool {
-	return o.some != nil
+	return o.some
 }
 
 func (o Option[T]) IsNone() bool {
-	return o.some == nil
+	return !o.some
 }
 
 func (o Option[T]) Expect(msg string) T {
 	if o.IsNone() {
-		panic(msg)
+		panicking.Panic(msg)
 	}
 
-	return *o.some
+	return o.value
 }
 
 func (o Option[T]) Unwrap() T {
@@ -43,11 +51,22 @@ func (o Option[T]) Unwrap() T {
 		o.Expect("unwrapped a none")
 	}
 
-	return *o.some
+	return o.value
+}
+
+// UnwrapOrDefault returns the wrapped value, or the default value of
+// `T` if the Option is None. See `defaults.Of` for how the default is
+// chosen.
+func (o Option[T]) UnwrapOrDefault() T {
+	if o.IsSome() {
+		return o.value
+	}
+
+	return defaults.Of[T]()
 }
 
 func Some[T any](data T) Option[T] {
-	return Option[T]{some: &data}
+	return Option[T]{value: data, some: true}
 }
 
 func None[T any]() Option[T] {
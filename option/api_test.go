@@ -0,0 +1,55 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+var (
+	sinkOption option.Option[int]
+	sinkInt    int
+)
+
+func double(n int) option.Option[int] {
+	return option.Some(n * 2)
+}
+
+func TestAllocs(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"Some", func() { sinkOption = option.Some(42) }},
+		{"None", func() { sinkOption = option.None[int]() }},
+		{"AndThen", func() { sinkOption = option.Some(21).AndThen(double) }},
+		{"Unwrap", func() { sinkInt = option.Some(42).Unwrap() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := testing.AllocsPerRun(100, tt.fn); n != 0 {
+				t.Errorf("got %v allocations, want 0", n)
+			}
+		})
+	}
+}
+
+func BenchmarkSome(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkOption = option.Some(i)
+	}
+}
+
+func BenchmarkAndThen(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkOption = option.Some(i).AndThen(double)
+	}
+}
+
+func BenchmarkUnwrap(b *testing.B) {
+	o := option.Some(42)
+	for i := 0; i < b.N; i++ {
+		sinkInt = o.Unwrap()
+	}
+}
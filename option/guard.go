@@ -0,0 +1,40 @@
+package option
+
+//go:generate go run ./internal/genpath
+
+// Guard returns `Some(ptr)` if `ptr` is not nil, and `None` otherwise.
+// With `Then` and `Get` it replaces chains of nil checks:
+//
+//	city := option.Get(option.Then(option.Guard(user), (*User).Address),
+//		func(a *Address) string { return a.City })
+//
+// For fixed paths of pointer fields, `Path2` through `Path6` do the
+// same in one call.
+func Guard[T any](ptr *T) Option[*T] {
+	if ptr == nil {
+		return None[*T]()
+	}
+
+	return Some(ptr)
+}
+
+// Then calls `fn` on the pointer in `o` and guards the pointer it
+// returns. It returns `None` if `o` is None or `fn` returns nil.
+func Then[A, B any](o Option[*A], fn func(*A) *B) Option[*B] {
+	if o.IsNone() {
+		return None[*B]()
+	}
+
+	return Guard(fn(o.value))
+}
+
+// Get calls `fn` on the pointer in `o` and returns its result in
+// `Some`, or `None` if `o` is None. It ends a chain of `Then` calls by
+// reading a field that is not a pointer.
+func Get[A, B any](o Option[*A], fn func(*A) B) Option[B] {
+	if o.IsNone() {
+		return None[B]()
+	}
+
+	return Some(fn(o.value))
+}
@@ -0,0 +1,30 @@
+package option
+
+import (
+	"fmt"
+	"io"
+)
+
+// Key returns a canonical string for `o`: "None", or "Some(v)" with
+// `v` printed in Go syntax. Options holding equal plain values, such
+// as numbers, strings, and structs and slices of them, get equal Keys;
+// values holding pointers print their addresses.
+//
+// An Option of a comparable type is itself comparable, since the value
+// is held inline, and can be used directly as a key in Go maps and in
+// `hashmap` and `hashset`. Key is for Options of slices, maps, and
+// other types that are not.
+func (o Option[T]) Key() string {
+	if o.IsNone() {
+		return "None"
+	}
+
+	return fmt.Sprintf("Some(%#v)", o.value)
+}
+
+// Hash writes the Key of `o` to `h`, typically a `hash.Hash` or a
+// `*maphash.Hash`, so an Option can be folded into the hash of a
+// larger value.
+func (o Option[T]) Hash(h io.Writer) {
+	_, _ = io.WriteString(h, o.Key())
+}
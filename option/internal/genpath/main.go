@@ -0,0 +1,58 @@
+// Command genpath writes option/path.go, which holds `Path2` through
+// `Path6`. Go has no variadic type parameters, so each arity is spelled
+// out; generating them keeps the copies in step. Run it with
+// `go generate ./option`.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+const maxSteps = 6
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by genpath; DO NOT EDIT.\n\npackage option\n")
+
+	for n := 2; n <= maxSteps; n++ {
+		types := make([]string, n+1)
+		for i := range types {
+			types[i] = string(rune('A' + i))
+		}
+
+		last := types[n]
+		params := []string{"p *A"}
+		for i := 1; i <= n; i++ {
+			params = append(params, fmt.Sprintf("f%d func(*%s) *%s", i, types[i-1], types[i]))
+		}
+
+		fmt.Fprintf(&b, "\n// Path%d follows %d pointer-returning steps from `p`, returning `None`\n", n, n)
+		b.WriteString("// at the first nil pointer, including `p` itself. See `Guard`.\n")
+		fmt.Fprintf(&b, "func Path%d[%s any](%s) Option[*%s] {\n", n, strings.Join(types, ", "), strings.Join(params, ", "), last)
+		fmt.Fprintf(&b, "\tif p == nil {\n\t\treturn None[*%s]()\n\t}\n", last)
+		prev := "p"
+		for i := 1; i <= n; i++ {
+			v := fmt.Sprintf("p%d", i)
+			fmt.Fprintf(&b, "\t%s := f%d(%s)\n", v, i, prev)
+			if i < n {
+				fmt.Fprintf(&b, "\tif %s == nil {\n\t\treturn None[*%s]()\n\t}\n", v, last)
+			}
+			prev = v
+		}
+		fmt.Fprintf(&b, "\n\treturn Guard(%s)\n}\n", prev)
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("genpath: %v\n%s", err, b.Bytes())
+	}
+
+	if err := os.WriteFile("path.go", src, 0o644); err != nil {
+		log.Fatalf("genpath: %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+package option
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"time"
+)
+
+// LogNone logs `msg` with `logger` at warn level if `o` is None, and
+// returns `o` unchanged either way, so it can sit anywhere in a chain.
+// The record's source location is the caller of LogNone. A nil
+// `logger` uses `slog.Default()`.
+func (o Option[T]) LogNone(logger *slog.Logger, msg string) Option[T] {
+	if o.IsSome() {
+		return o
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	ctx := context.Background()
+	if !logger.Enabled(ctx, slog.LevelWarn) {
+		return o
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:])
+	rec := slog.NewRecord(time.Now(), slog.LevelWarn, msg, pcs[0])
+	_ = logger.Handler().Handle(ctx, rec)
+
+	return o
+}
@@ -0,0 +1,118 @@
+// Code generated by genpath; DO NOT EDIT.
+
+package option
+
+// Path2 follows 2 pointer-returning steps from `p`, returning `None`
+// at the first nil pointer, including `p` itself. See `Guard`.
+func Path2[A, B, C any](p *A, f1 func(*A) *B, f2 func(*B) *C) Option[*C] {
+	if p == nil {
+		return None[*C]()
+	}
+	p1 := f1(p)
+	if p1 == nil {
+		return None[*C]()
+	}
+	p2 := f2(p1)
+
+	return Guard(p2)
+}
+
+// Path3 follows 3 pointer-returning steps from `p`, returning `None`
+// at the first nil pointer, including `p` itself. See `Guard`.
+func Path3[A, B, C, D any](p *A, f1 func(*A) *B, f2 func(*B) *C, f3 func(*C) *D) Option[*D] {
+	if p == nil {
+		return None[*D]()
+	}
+	p1 := f1(p)
+	if p1 == nil {
+		return None[*D]()
+	}
+	p2 := f2(p1)
+	if p2 == nil {
+		return None[*D]()
+	}
+	p3 := f3(p2)
+
+	return Guard(p3)
+}
+
+// Path4 follows 4 pointer-returning steps from `p`, returning `None`
+// at the first nil pointer, including `p` itself. See `Guard`.
+func Path4[A, B, C, D, E any](p *A, f1 func(*A) *B, f2 func(*B) *C, f3 func(*C) *D, f4 func(*D) *E) Option[*E] {
+	if p == nil {
+		return None[*E]()
+	}
+	p1 := f1(p)
+	if p1 == nil {
+		return None[*E]()
+	}
+	p2 := f2(p1)
+	if p2 == nil {
+		return None[*E]()
+	}
+	p3 := f3(p2)
+	if p3 == nil {
+		return None[*E]()
+	}
+	p4 := f4(p3)
+
+	return Guard(p4)
+}
+
+// Path5 follows 5 pointer-returning steps from `p`, returning `None`
+// at the first nil pointer, including `p` itself. See `Guard`.
+func Path5[A, B, C, D, E, F any](p *A, f1 func(*A) *B, f2 func(*B) *C, f3 func(*C) *D, f4 func(*D) *E, f5 func(*E) *F) Option[*F] {
+	if p == nil {
+		return None[*F]()
+	}
+	p1 := f1(p)
+	if p1 == nil {
+		return None[*F]()
+	}
+	p2 := f2(p1)
+	if p2 == nil {
+		return None[*F]()
+	}
+	p3 := f3(p2)
+	if p3 == nil {
+		return None[*F]()
+	}
+	p4 := f4(p3)
+	if p4 == nil {
+		return None[*F]()
+	}
+	p5 := f5(p4)
+
+	return Guard(p5)
+}
+
+// Path6 follows 6 pointer-returning steps from `p`, returning `None`
+// at the first nil pointer, including `p` itself. See `Guard`.
+func Path6[A, B, C, D, E, F, G any](p *A, f1 func(*A) *B, f2 func(*B) *C, f3 func(*C) *D, f4 func(*D) *E, f5 func(*E) *F, f6 func(*F) *G) Option[*G] {
+	if p == nil {
+		return None[*G]()
+	}
+	p1 := f1(p)
+	if p1 == nil {
+		return None[*G]()
+	}
+	p2 := f2(p1)
+	if p2 == nil {
+		return None[*G]()
+	}
+	p3 := f3(p2)
+	if p3 == nil {
+		return None[*G]()
+	}
+	p4 := f4(p3)
+	if p4 == nil {
+		return None[*G]()
+	}
+	p5 := f5(p4)
+	if p5 == nil {
+		return None[*G]()
+	}
+	p6 := f6(p5)
+
+	return Guard(p6)
+}
@@ -0,0 +1,48 @@
+// Package panics converts panics into Results at goroutine and
+// handler boundaries, so one misbehaving request or job cannot take
+// down the whole process, and the diagnostics survive.
+package panics
+
+import (
+	"runtime/debug"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Panic is a recovered panic. `Value` is the value passed to `panic`
+// and `Stack` is the goroutine's stack at the point of recovery. It is
+// the same type as `result.PanicError`, so a panic recovered by either
+// package matches the other in `errors.As`.
+type Panic = result.PanicError
+
+// Safe calls `fn` and recovers any panic it raises. The error Result
+// holds the recovered `*Panic`.
+func Safe(fn func()) result.Result[struct{}, *Panic] {
+	return SafeResult(func() struct{} {
+		fn()
+		return struct{}{}
+	})
+}
+
+// SafeResult calls `fn` and returns its value as an ok Result, or
+// the recovered `*Panic` as an error Result if `fn` panics.
+func SafeResult[T any](fn func() T) (res result.Result[T, *Panic]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = result.Err[T](&Panic{Value: r, Stack: debug.Stack()})
+		}
+	}()
+
+	return result.OkOf[T, *Panic](fn())
+}
+
+// Go runs `fn` in a new goroutine. If `fn` panics, the panic is
+// recovered and passed to `handle` instead of crashing the process.
+// `handle` may be nil to discard panics.
+func Go(fn func(), handle func(*Panic)) {
+	go func() {
+		if res := Safe(fn); res.IsErr() && handle != nil {
+			handle(res.UnwrapErr())
+		}
+	}()
+}
@@ -0,0 +1,186 @@
+// Package parse converts strings into typed values, returning a
+// `Result` rather than a value and an error. It is loosely modeled on
+// Rust's `str::parse` and the `FromStr` trait: built-in types are
+// handled directly, and user types take part by implementing `FromStr`
+// or by being registered.
+package parse
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// ErrUnsupported is wrapped by the error returned for a type that
+// `Parse` does not know how to handle.
+var ErrUnsupported = errors.New("unsupported type")
+
+// Error reports a string that could not be parsed as a type.
+type Error struct {
+	Type  reflect.Type
+	Input string
+	Err   error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("parse: cannot parse %q as %s: %v", e.Input, e.Type, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// FromStr is implemented by types that can parse themselves from a
+// string. The method is called on the zero value of the type (or a
+// pointer to it), so it must not rely on the receiver's fields.
+type FromStr[T any] interface {
+	FromStr(s string) result.Result[T, error]
+}
+
+var registry sync.Map // reflect.Type -> func(string) (reflect.Value, error)
+
+// Register makes `Parse[T]` use `fn`. It is meant for types from other
+// modules that cannot be given a `FromStr` method. Registering a type
+// again replaces the previous function. A registered function takes
+// precedence over every other way of parsing `T`.
+func Register[T any](fn func(s string) result.Result[T, error]) {
+	registry.Store(typeOf[T](), func(s string) (reflect.Value, error) {
+		res := fn(s)
+		if res.IsErr() {
+			return reflect.Value{}, res.UnwrapErr()
+		}
+
+		v := res.Unwrap()
+		return reflect.ValueOf(&v).Elem(), nil
+	})
+}
+
+// Parse parses `s` as a `T`. It tries, in order: a function registered
+// with `Register`; a `FromStr` method on `T` or `*T`; an
+// `encoding.TextUnmarshaler` implementation on `*T`; and finally the
+// built-in handling of strings, booleans, integers, floats, and
+// `time.Duration`. Errors that do not come from a user function are
+// reported as `*Error`.
+func Parse[T any](s string) result.Result[T, error] {
+	v, err := parseValue(typeOf[T](), s)
+	if err != nil {
+		return result.Err[T](err)
+	}
+
+	return result.Ok(v.Interface().(T))
+}
+
+// ParseValue is `Parse` for a type known only at run time. The
+// returned value has type `t`. It is meant for reflective callers such
+// as configuration loaders; prefer `Parse` when the type is static.
+func ParseValue(t reflect.Type, s string) result.Result[reflect.Value, error] {
+	return result.Match(parseValue(t, s))
+}
+
+func parseValue(t reflect.Type, s string) (reflect.Value, error) {
+	if fn, ok := registry.Load(t); ok {
+		return fn.(func(string) (reflect.Value, error))(s)
+	}
+
+	if v, ok, err := fromStr(t, s); ok {
+		return v, err
+	}
+
+	ptr := reflect.New(t)
+	if u, ok := ptr.Interface().(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText([]byte(s)); err != nil {
+			return reflect.Value{}, &Error{Type: t, Input: s, Err: err}
+		}
+
+		return ptr.Elem(), nil
+	}
+
+	return builtin(t, s)
+}
+
+// fromStr calls the `FromStr` method of `t` or `*t` if it has the
+// shape of `FromStr[t]`, reporting whether it did. The method set of
+// `*t` includes that of `t`, so only the pointer is checked.
+func fromStr(t reflect.Type, s string) (reflect.Value, bool, error) {
+	m := reflect.New(t).MethodByName("FromStr")
+	if !m.IsValid() {
+		return reflect.Value{}, false, nil
+	}
+
+	mt := m.Type()
+	if mt.NumIn() != 1 || mt.In(0).Kind() != reflect.String || mt.NumOut() != 1 {
+		return reflect.Value{}, false, nil
+	}
+
+	res := m.Call([]reflect.Value{reflect.ValueOf(s).Convert(mt.In(0))})[0]
+	isErr := res.MethodByName("IsErr")
+	unwrap := res.MethodByName("Unwrap")
+	unwrapErr := res.MethodByName("UnwrapErr")
+	if !isErr.IsValid() || !unwrap.IsValid() || !unwrapErr.IsValid() ||
+		unwrap.Type().NumOut() != 1 || unwrap.Type().Out(0) != t {
+		return reflect.Value{}, false, nil
+	}
+
+	if isErr.Call(nil)[0].Bool() {
+		err, _ := unwrapErr.Call(nil)[0].Interface().(error)
+		return reflect.Value{}, true, err
+	}
+
+	return unwrap.Call(nil)[0], true, nil
+}
+
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// builtin parses `s` as one of the built-in kinds, returning a value
+// of type `t`, which may be a named type with such a kind.
+func builtin(t reflect.Type, s string) (reflect.Value, error) {
+	v := reflect.New(t).Elem()
+	var err error
+	switch {
+	case t == durationType:
+		var d time.Duration
+		if d, err = time.ParseDuration(s); err == nil {
+			v.SetInt(int64(d))
+		}
+	case t.Kind() == reflect.String:
+		v.SetString(s)
+	case t.Kind() == reflect.Bool:
+		var b bool
+		if b, err = strconv.ParseBool(s); err == nil {
+			v.SetBool(b)
+		}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64:
+		var n int64
+		if n, err = strconv.ParseInt(s, 0, t.Bits()); err == nil {
+			v.SetInt(n)
+		}
+	case t.Kind() >= reflect.Uint && t.Kind() <= reflect.Uintptr:
+		var n uint64
+		if n, err = strconv.ParseUint(s, 0, t.Bits()); err == nil {
+			v.SetUint(n)
+		}
+	case t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(s, t.Bits()); err == nil {
+			v.SetFloat(f)
+		}
+	default:
+		err = ErrUnsupported
+	}
+
+	if err != nil {
+		return reflect.Value{}, &Error{Type: t, Input: s, Err: err}
+	}
+
+	return v, nil
+}
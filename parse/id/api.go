@@ -0,0 +1,140 @@
+// Package id provides `Result`-returning parsers for identifiers such
+// as UUIDs and email addresses. Failures are reported as
+// `*parse.Error`, so they can be handled the same way as errors from
+// `parse.Parse`.
+package id
+
+import (
+	"encoding/hex"
+	"errors"
+	"net/mail"
+	"reflect"
+	"strings"
+
+	"github.com/jwhittle933/rs.go/parse"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+var (
+	// ErrUUIDFormat is wrapped by the error returned from `ParseUUID`
+	// when the input is not a well-formed UUID.
+	ErrUUIDFormat = errors.New("invalid uuid format")
+	// ErrEmailFormat is wrapped by the error returned from `Email`
+	// when the input is more than a bare address.
+	ErrEmailFormat = errors.New("not a bare email address")
+)
+
+// UUID is a 128-bit universally unique identifier, as described in
+// RFC 4122. It implements `parse.FromStr`, so `parse.Parse[id.UUID]`
+// works, as well as `encoding.TextMarshaler` and
+// `encoding.TextUnmarshaler`.
+type UUID [16]byte
+
+// ParseUUID parses `s` as a UUID. Both the canonical hyphenated form,
+// "6ba7b810-9dad-11d1-80b4-00c04fd430c8", and the bare 32-digit hex
+// form are accepted, optionally wrapped in braces or prefixed with
+// "urn:uuid:". Hex digits may be of either case.
+func ParseUUID(s string) result.Result[UUID, error] {
+	var u UUID
+	err := u.decode(s)
+
+	return wrap(u, s, err)
+}
+
+// FromStr implements `parse.FromStr`.
+func (UUID) FromStr(s string) result.Result[UUID, error] {
+	return ParseUUID(s)
+}
+
+// IsNil reports whether `u` is the nil UUID, with all bits zero.
+func (u UUID) IsNil() bool {
+	return u == UUID{}
+}
+
+// Version returns the version number of `u`, taken from the high
+// nibble of its seventh byte.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// String returns the canonical lowercase hyphenated form of `u`.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+
+	return string(buf[:])
+}
+
+// MarshalText implements `encoding.TextMarshaler`.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements `encoding.TextUnmarshaler`.
+func (u *UUID) UnmarshalText(text []byte) error {
+	return u.decode(string(text))
+}
+
+func (u *UUID) decode(s string) error {
+	switch {
+	case strings.HasPrefix(strings.ToLower(s), "urn:uuid:"):
+		s = s[len("urn:uuid:"):]
+	case len(s) > 1 && s[0] == '{' && s[len(s)-1] == '}':
+		s = s[1 : len(s)-1]
+	}
+
+	switch len(s) {
+	case 32:
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return ErrUUIDFormat
+		}
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	default:
+		return ErrUUIDFormat
+	}
+
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return ErrUUIDFormat
+	}
+
+	return nil
+}
+
+// Email parses `s` as a bare RFC 5322 address such as
+// "gopher@example.com", returning the address. Surrounding whitespace
+// is ignored, but display names and angle brackets are rejected, since
+// user input that contains them is rarely what was meant.
+func Email(s string) result.Result[string, error] {
+	trimmed := strings.TrimSpace(s)
+	a, err := mail.ParseAddress(trimmed)
+	if err != nil {
+		return wrap("", s, err)
+	}
+
+	if a.Name != "" || a.Address != trimmed {
+		return wrap("", s, ErrEmailFormat)
+	}
+
+	return result.Ok(a.Address)
+}
+
+func wrap[T any](v T, s string, err error) result.Result[T, error] {
+	if err != nil {
+		return result.Err[T](error(&parse.Error{
+			Type:  reflect.TypeOf((*T)(nil)).Elem(),
+			Input: s,
+			Err:   err,
+		}))
+	}
+
+	return result.Ok(v)
+}
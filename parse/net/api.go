@@ -0,0 +1,64 @@
+// Package net provides `Result`-returning parsers for network
+// addresses and URLs. Failures are reported as `*parse.Error`, so they
+// can be handled the same way as errors from `parse.Parse`.
+package net
+
+import (
+	"errors"
+	stdnet "net"
+	"net/netip"
+	"net/url"
+	"reflect"
+
+	"github.com/jwhittle933/rs.go/parse"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// ErrNotAbsolute is wrapped by the error returned from `URL` when the
+// input has no scheme or host.
+var ErrNotAbsolute = errors.New("url is not absolute")
+
+// URL parses `s` as an absolute URL. Unlike `url.Parse`, which accepts
+// nearly any string, it requires both a scheme and a host.
+func URL(s string) result.Result[*url.URL, error] {
+	u, err := url.Parse(s)
+	if err == nil && (u.Scheme == "" || u.Host == "") {
+		err = ErrNotAbsolute
+	}
+
+	return wrap(u, s, err)
+}
+
+// IP parses `s` as an IPv4 or IPv6 address, with an optional zone.
+func IP(s string) result.Result[netip.Addr, error] {
+	a, err := netip.ParseAddr(s)
+	return wrap(a, s, err)
+}
+
+// CIDR parses `s` as an IP prefix in CIDR notation, such as
+// "10.0.0.0/8". The host bits are kept; call `Masked` on the result to
+// drop them.
+func CIDR(s string) result.Result[netip.Prefix, error] {
+	p, err := netip.ParsePrefix(s)
+	return wrap(p, s, err)
+}
+
+// MAC parses `s` as an IEEE 802 MAC-48, EUI-48, EUI-64, or 20-octet
+// IP over InfiniBand link-layer address. See `net.ParseMAC` for the
+// accepted formats.
+func MAC(s string) result.Result[stdnet.HardwareAddr, error] {
+	m, err := stdnet.ParseMAC(s)
+	return wrap(m, s, err)
+}
+
+func wrap[T any](v T, s string, err error) result.Result[T, error] {
+	if err != nil {
+		return result.Err[T](error(&parse.Error{
+			Type:  reflect.TypeOf((*T)(nil)).Elem(),
+			Input: s,
+			Err:   err,
+		}))
+	}
+
+	return result.Ok(v)
+}
@@ -0,0 +1,269 @@
+// Package quick provides random generators and shrinkers for property
+// tests of code built on `Option` and `Result`. Generators plug into
+// `testing/quick` through `Values`, and `Check` adds the shrinking that
+// `testing/quick` lacks, so a failing input is reported in its
+// smallest form.
+package quick
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	testquick "testing/quick"
+
+	"github.com/jwhittle933/rs.go/ops"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// defaultSize is the size hint passed to generators by `Values` and
+// `Check`. It matches the one `testing/quick` uses.
+const defaultSize = 50
+
+// Generator produces a random `T`. `size` is a hint for how large a
+// value to produce, such as the length of a slice.
+type Generator[T any] func(r *rand.Rand, size int) T
+
+// Value calls `g` and wraps the value for `testing/quick`.
+func (g Generator[T]) Value(r *rand.Rand, size int) reflect.Value {
+	v := g(r, size)
+	return reflect.ValueOf(&v).Elem()
+}
+
+// Valuer is implemented by every `Generator`. It lets generators of
+// different types be passed together to `Values`.
+type Valuer interface {
+	Value(r *rand.Rand, size int) reflect.Value
+}
+
+// Values returns a function for `testing/quick.Config.Values` that
+// fills each argument of the property from the matching generator.
+// The number of generators must match the number of arguments.
+func Values(gens ...Valuer) func([]reflect.Value, *rand.Rand) {
+	return func(args []reflect.Value, r *rand.Rand) {
+		if len(args) != len(gens) {
+			panic(fmt.Sprintf("quick: %d generators for %d arguments", len(gens), len(args)))
+		}
+
+		for i, g := range gens {
+			args[i] = g.Value(r, defaultSize)
+		}
+	}
+}
+
+// Arbitrary returns a generator of arbitrary `T` values, as produced
+// by `testing/quick.Value`. It panics when called if `testing/quick`
+// cannot generate a `T`.
+func Arbitrary[T any]() Generator[T] {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	return func(r *rand.Rand, _ int) T {
+		v, ok := testquick.Value(t, r)
+		if !ok {
+			panic(fmt.Sprintf("quick: cannot generate values of type %s", t))
+		}
+
+		return v.Interface().(T)
+	}
+}
+
+// Const returns a generator that always produces `v`.
+func Const[T any](v T) Generator[T] {
+	return func(*rand.Rand, int) T {
+		return v
+	}
+}
+
+// OneOf returns a generator that picks uniformly from `vs`. It panics
+// if `vs` is empty.
+func OneOf[T any](vs ...T) Generator[T] {
+	if len(vs) == 0 {
+		panic("quick: OneOf needs at least one value")
+	}
+
+	return func(r *rand.Rand, _ int) T {
+		return vs[r.Intn(len(vs))]
+	}
+}
+
+// IntRange returns a generator of integers in [lo, hi]. It panics if
+// `lo > hi`.
+func IntRange(lo, hi int) Generator[int] {
+	if lo > hi {
+		panic("quick: IntRange with lo > hi")
+	}
+
+	return func(r *rand.Rand, _ int) int {
+		return lo + r.Intn(hi-lo+1)
+	}
+}
+
+// SliceOf returns a generator of slices of up to `size` elements
+// drawn from `g`.
+func SliceOf[T any](g Generator[T]) Generator[[]T] {
+	return func(r *rand.Rand, size int) []T {
+		out := make([]T, r.Intn(size+1))
+		for i := range out {
+			out[i] = g(r, size)
+		}
+
+		return out
+	}
+}
+
+// Option returns a generator of `Option[T]` that produces `None` about
+// a quarter of the time and otherwise wraps a value from `g`.
+func Option[T any](g Generator[T]) Generator[option.Option[T]] {
+	return func(r *rand.Rand, size int) option.Option[T] {
+		if r.Intn(4) == 0 {
+			return option.None[T]()
+		}
+
+		return option.Some(g(r, size))
+	}
+}
+
+// Result returns a generator of `Result[T, E]` that produces an error
+// from `errGen` about a quarter of the time and otherwise an ok value
+// from `okGen`.
+func Result[T, E any](okGen Generator[T], errGen Generator[E]) Generator[result.Result[T, E]] {
+	return func(r *rand.Rand, size int) result.Result[T, E] {
+		if r.Intn(4) == 0 {
+			return result.Err[T](errGen(r, size))
+		}
+
+		return result.OkOf[T, E](okGen(r, size))
+	}
+}
+
+// Shrinker returns candidate values that are simpler than `v`, most
+// aggressive first. It returns nothing for a value that cannot be
+// simplified further.
+type Shrinker[T any] func(v T) []T
+
+// ShrinkInteger shrinks an integer toward zero: to zero itself, to
+// half its value, and one step closer.
+func ShrinkInteger[T ops.Integer](v T) []T {
+	if v == 0 {
+		return nil
+	}
+
+	out := []T{0}
+	if half := v / 2; half != 0 {
+		out = append(out, half)
+	}
+
+	step := v - 1
+	if v < 0 {
+		step = v + 1
+	}
+	if step != 0 && step != v/2 {
+		out = append(out, step)
+	}
+
+	return out
+}
+
+// ShrinkSlice shrinks a slice by dropping its second half, its first
+// half, and then each single element. It does not shrink elements.
+func ShrinkSlice[T any](v []T) [][]T {
+	if len(v) == 0 {
+		return nil
+	}
+
+	out := [][]T{nil}
+	if half := len(v) / 2; half > 0 {
+		out = append(out, v[:half], v[half:])
+	}
+
+	if len(v) > 1 {
+		for i := range v {
+			dropped := append(append([]T{}, v[:i]...), v[i+1:]...)
+			out = append(out, dropped)
+		}
+	}
+
+	return out
+}
+
+// ShrinkOption shrinks `Some(v)` to `None`, then to `Some` of each
+// shrink of `v`. `None` does not shrink.
+func ShrinkOption[T any](s Shrinker[T]) Shrinker[option.Option[T]] {
+	return func(o option.Option[T]) []option.Option[T] {
+		if o.IsNone() {
+			return nil
+		}
+
+		out := []option.Option[T]{option.None[T]()}
+		if s != nil {
+			for _, v := range s(o.Unwrap()) {
+				out = append(out, option.Some(v))
+			}
+		}
+
+		return out
+	}
+}
+
+// ShrinkResult shrinks the value inside a `Result` with `okShrink` or
+// `errShrink`, keeping its variant. Either shrinker may be nil.
+func ShrinkResult[T, E any](okShrink Shrinker[T], errShrink Shrinker[E]) Shrinker[result.Result[T, E]] {
+	return func(res result.Result[T, E]) []result.Result[T, E] {
+		var out []result.Result[T, E]
+		switch {
+		case res.IsOk() && okShrink != nil:
+			for _, v := range okShrink(res.Unwrap()) {
+				out = append(out, result.OkOf[T, E](v))
+			}
+		case res.IsErr() && errShrink != nil:
+			for _, e := range errShrink(res.UnwrapErr()) {
+				out = append(out, result.Err[T](e))
+			}
+		}
+
+		return out
+	}
+}
+
+// maxShrinks bounds the number of successful shrink steps `Minimize`
+// takes, in case a shrinker never bottoms out.
+const maxShrinks = 1000
+
+// Minimize repeatedly replaces `v` with the first of its shrinks for
+// which `fails` still reports true, and returns the value once no
+// shrink fails. A nil shrinker returns `v` unchanged.
+func Minimize[T any](v T, shrink Shrinker[T], fails func(T) bool) T {
+	if shrink == nil {
+		return v
+	}
+
+	for i := 0; i < maxShrinks; i++ {
+		next := option.None[T]()
+		for _, c := range shrink(v) {
+			if fails(c) {
+				next = option.Some(c)
+				break
+			}
+		}
+
+		if next.IsNone() {
+			break
+		}
+		v = next.Unwrap()
+	}
+
+	return v
+}
+
+// Check runs `prop` on `n` values from `gen` and returns the first
+// counterexample, minimized with `shrink`, or `None` if the property
+// held every time. `shrink` may be nil.
+func Check[T any](r *rand.Rand, n int, gen Generator[T], shrink Shrinker[T], prop func(T) bool) option.Option[T] {
+	fails := func(v T) bool { return !prop(v) }
+	for i := 0; i < n; i++ {
+		if v := gen(r, defaultSize); fails(v) {
+			return option.Some(Minimize(v, shrink, fails))
+		}
+	}
+
+	return option.None[T]()
+}
@@ -0,0 +1,112 @@
+// Package rc is an implementation of reference-counted values,
+// loosely modeled on Rust's `Rc` and `Arc`. Go is garbage collected,
+// so counting is not about memory: it gives pooled resources
+// (connections, mmap regions, file handles) a deterministic point
+// at which to be released. The registered drop function runs exactly
+// once, when the last strong handle is dropped.
+//
+// Each handle must be dropped exactly once. Dropping a handle twice
+// is a no-op; forgetting to drop one means the value is never released.
+package rc
+
+import (
+	"github.com/jwhittle933/rs.go/option"
+)
+
+type rcBox[T any] struct {
+	value  T
+	strong int
+	weak   int
+	drop   func(T)
+}
+
+// Rc is a strong handle to a reference-counted value. Rc is not safe
+// for concurrent use; use `Arc` to share a value across goroutines.
+type Rc[T any] struct {
+	box     *rcBox[T]
+	dropped bool
+}
+
+// New wraps `value` in an Rc with a strong count of one. `drop`,
+// which may be nil, is called with the value when the strong count
+// reaches zero.
+func New[T any](value T, drop func(T)) *Rc[T] {
+	return &Rc[T]{box: &rcBox[T]{value: value, strong: 1, drop: drop}}
+}
+
+// Get returns the wrapped value. Calling Get on a dropped handle panics.
+func (r *Rc[T]) Get() T {
+	if r.dropped {
+		panic("rc: Get called on a dropped Rc")
+	}
+
+	return r.box.value
+}
+
+// Clone returns a new strong handle to the same value, incrementing
+// the strong count. Cloning a dropped handle panics.
+func (r *Rc[T]) Clone() *Rc[T] {
+	if r.dropped {
+		panic("rc: Clone called on a dropped Rc")
+	}
+
+	r.box.strong++
+	return &Rc[T]{box: r.box}
+}
+
+// Downgrade returns a Weak handle that does not keep the value alive.
+func (r *Rc[T]) Downgrade() *Weak[T] {
+	r.box.weak++
+	return &Weak[T]{box: r.box}
+}
+
+// Drop releases this handle. When the last strong handle is dropped,
+// the drop function is called with the value.
+func (r *Rc[T]) Drop() {
+	if r.dropped {
+		return
+	}
+
+	r.dropped = true
+	r.box.strong--
+	if r.box.strong == 0 && r.box.drop != nil {
+		r.box.drop(r.box.value)
+	}
+}
+
+// StrongCount returns the number of live strong handles.
+func (r *Rc[T]) StrongCount() int {
+	return r.box.strong
+}
+
+// WeakCount returns the number of live weak handles.
+func (r *Rc[T]) WeakCount() int {
+	return r.box.weak
+}
+
+// Weak is a non-owning handle to an Rc's value.
+type Weak[T any] struct {
+	box     *rcBox[T]
+	dropped bool
+}
+
+// Upgrade returns a new strong handle if the value is still alive,
+// otherwise None.
+func (w *Weak[T]) Upgrade() option.Option[*Rc[T]] {
+	if w.dropped || w.box.strong == 0 {
+		return option.None[*Rc[T]]()
+	}
+
+	w.box.strong++
+	return option.Some(&Rc[T]{box: w.box})
+}
+
+// Drop releases this weak handle.
+func (w *Weak[T]) Drop() {
+	if w.dropped {
+		return
+	}
+
+	w.dropped = true
+	w.box.weak--
+}
@@ -0,0 +1,110 @@
+package rc
+
+import (
+	"sync/atomic"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+type arcBox[T any] struct {
+	value  T
+	strong int64
+	weak   int64
+	drop   func(T)
+}
+
+// Arc is the thread-safe counterpart of `Rc`. Counts are updated
+// atomically, so handles may be cloned and dropped from any goroutine.
+// Each individual handle should still be dropped by a single owner.
+type Arc[T any] struct {
+	box     *arcBox[T]
+	dropped uint32
+}
+
+// NewArc wraps `value` in an Arc with a strong count of one. `drop`,
+// which may be nil, is called with the value when the strong count
+// reaches zero.
+func NewArc[T any](value T, drop func(T)) *Arc[T] {
+	return &Arc[T]{box: &arcBox[T]{value: value, strong: 1, drop: drop}}
+}
+
+// Get returns the wrapped value. Calling Get on a dropped handle panics.
+func (a *Arc[T]) Get() T {
+	if atomic.LoadUint32(&a.dropped) == 1 {
+		panic("rc: Get called on a dropped Arc")
+	}
+
+	return a.box.value
+}
+
+// Clone returns a new strong handle to the same value, incrementing
+// the strong count. Cloning a dropped handle panics.
+func (a *Arc[T]) Clone() *Arc[T] {
+	if atomic.LoadUint32(&a.dropped) == 1 {
+		panic("rc: Clone called on a dropped Arc")
+	}
+
+	atomic.AddInt64(&a.box.strong, 1)
+	return &Arc[T]{box: a.box}
+}
+
+// Downgrade returns a WeakArc handle that does not keep the value alive.
+func (a *Arc[T]) Downgrade() *WeakArc[T] {
+	atomic.AddInt64(&a.box.weak, 1)
+	return &WeakArc[T]{box: a.box}
+}
+
+// Drop releases this handle. When the last strong handle is dropped,
+// the drop function is called with the value.
+func (a *Arc[T]) Drop() {
+	if !atomic.CompareAndSwapUint32(&a.dropped, 0, 1) {
+		return
+	}
+
+	if atomic.AddInt64(&a.box.strong, -1) == 0 && a.box.drop != nil {
+		a.box.drop(a.box.value)
+	}
+}
+
+// StrongCount returns the number of live strong handles.
+func (a *Arc[T]) StrongCount() int {
+	return int(atomic.LoadInt64(&a.box.strong))
+}
+
+// WeakCount returns the number of live weak handles.
+func (a *Arc[T]) WeakCount() int {
+	return int(atomic.LoadInt64(&a.box.weak))
+}
+
+// WeakArc is a non-owning handle to an Arc's value.
+type WeakArc[T any] struct {
+	box     *arcBox[T]
+	dropped uint32
+}
+
+// Upgrade returns a new strong handle if the value is still alive,
+// otherwise None. A value whose count has reached zero can never be
+// revived, even by a concurrent Upgrade.
+func (w *WeakArc[T]) Upgrade() option.Option[*Arc[T]] {
+	if atomic.LoadUint32(&w.dropped) == 1 {
+		return option.None[*Arc[T]]()
+	}
+
+	for {
+		n := atomic.LoadInt64(&w.box.strong)
+		if n == 0 {
+			return option.None[*Arc[T]]()
+		}
+
+		if atomic.CompareAndSwapInt64(&w.box.strong, n, n+1) {
+			return option.Some(&Arc[T]{box: w.box})
+		}
+	}
+}
+
+// Drop releases this weak handle.
+func (w *WeakArc[T]) Drop() {
+	if atomic.CompareAndSwapUint32(&w.dropped, 0, 1) {
+		atomic.AddInt64(&w.box.weak, -1)
+	}
+}
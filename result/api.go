@@ -3,8 +3,11 @@
 package result
 
 import (
+	"fmt"
 	"reflect"
 
+	"github.com/jwhittle933/rs.go/defaults"
+	"github.com/jwhittle933/rs.go/internal/panicking"
 	"github.com/jwhittle933/rs.go/option"
 )
 
@@ -13,11 +16,25 @@ import (
 // Becuase `Result` methods return `Result` interfaces, you can chain
 // your method calls together and "happy path" a procedural chain without
 // checking for an error until the end of the procedure.
+//
+// The ok value and error are held inline rather than behind pointers,
+// so that wrapping a value does not allocate.
 type Result[T any, E any] struct {
-	ok  *T
-	err *E
+	ok    T
+	err   E
+	state state
 }
 
+// state records which variant a Result holds. The zero Result is
+// neither ok nor an error.
+type state uint8
+
+const (
+	neither state = iota
+	okState
+	errState
+)
+
 // And returns `r` if the result is `ok`. Otherwise
 // returns the original result.
 func (r Result[T, E]) And(res Result[T, E]) Result[T, E] {
@@ -32,7 +49,7 @@ func (r Result[T, E]) And(res Result[T, E]) Result[T, E] {
 // returns the original result.
 func (r Result[T, E]) AndThen(fn func(data T) Result[T, E]) Result[T, E] {
 	if r.IsOk() {
-		return fn(*r.ok)
+		return fn(r.ok)
 	}
 
 	return r
@@ -50,7 +67,7 @@ func (r Result[T, E]) Or(res Result[T, E]) Result[T, E] {
 // OrElse returns the `res` if `r` is an error, otherwise calls `fn` on the error.
 func (r Result[T, E]) OrElse(fn func(e E) Result[T, E]) Result[T, E] {
 	if r.IsErr() {
-		return fn(*r.err)
+		return fn(r.err)
 	}
 
 	return r
@@ -64,7 +81,7 @@ func (r Result[T, E]) Contains(data T) bool {
 		// it may not be possible to compare
 		// without reflection. Constraining T
 		// would severly hinder the API.
-		if reflect.DeepEqual(*r.ok, data) {
+		if reflect.DeepEqual(r.ok, data) {
 			return true
 		}
 	}
@@ -72,16 +89,35 @@ func (r Result[T, E]) Contains(data T) bool {
 	return false
 }
 
+// ContainsFunc reports whether the Result is ok and `pred` returns
+// true for its data. Unlike `Contains` it needs no reflection.
+func (r Result[T, E]) ContainsFunc(pred func(data T) bool) bool {
+	return r.IsOk() && pred(r.ok)
+}
+
+// ContainsErr compares the wrapped error to `e`, with the same
+// reflection-based equality as `Contains`.
+func (r Result[T, E]) ContainsErr(e E) bool {
+	return r.IsErr() && reflect.DeepEqual(r.err, e)
+}
+
+// ContainsErrFunc reports whether the Result is an error for which
+// `pred` returns true.
+func (r Result[T, E]) ContainsErrFunc(pred func(e E) bool) bool {
+	return r.IsErr() && pred(r.err)
+}
+
 // Map calls `m` on the underlying data of
 // `Result`. The return from `m` is wrapped and returned. In
 // the event of an error, `m` is not called and the
 // error Result is returned unchanged. Go's generics
 // don't allow for new parameter introduction in an interface,
-// so Map can operate only on `T`.
+// so Map can operate only on `T`; use the `Map` function to change
+// the type.
 func (r Result[T, E]) Map(fn func(data T) T) Result[T, E] {
 	if r.IsOk() {
-		op := fn(*r.ok)
-		return Result[T, E]{ok: &op}
+		op := fn(r.ok)
+		return Result[T, E]{ok: op, state: okState}
 	}
 
 	return r
@@ -92,11 +128,12 @@ func (r Result[T, E]) Map(fn func(data T) T) Result[T, E] {
 // the event of an error, `m` is not called and the
 // error Result is returned unchanged. Go's generics
 // don't allow for new parameter introduction in an interface,
-// so Map can operate only on `T` or `E`.
+// so Map can operate only on `T` or `E`; use the `MapErr` function
+// to change the type.
 func (r Result[T, E]) MapErr(fn func(e E) E) Result[T, E] {
 	if r.IsErr() {
-		op := fn(*r.err)
-		return Result[T, E]{err: &op}
+		op := fn(r.err)
+		return Result[T, E]{err: op, state: errState}
 	}
 
 	return r
@@ -106,17 +143,48 @@ func (r Result[T, E]) MapErr(fn func(e E) E) Result[T, E] {
 // to the wrapped value.
 func (r Result[T, E]) MapOr(def T, fn func(data T) T) T {
 	if r.IsOk() {
-		return fn(*r.ok)
+		return fn(r.ok)
 	}
 
 	return def
 }
 
+// Inspect calls `fn` on the underlying data if the Result is ok, for
+// its side effect, and returns `r` unchanged either way.
+func (r Result[T, E]) Inspect(fn func(data T)) Result[T, E] {
+	if r.IsOk() {
+		fn(r.ok)
+	}
+
+	return r
+}
+
+// InspectErr calls `fn` on the underlying error if the Result is an
+// error, for its side effect, and returns `r` unchanged either way.
+func (r Result[T, E]) InspectErr(fn func(e E)) Result[T, E] {
+	if r.IsErr() {
+		fn(r.err)
+	}
+
+	return r
+}
+
+// MapOrElse applies `fn` to the wrapped value, or returns `defFn`
+// applied to the error if `r` is an error. Unlike `MapOr`, the default
+// is only built when needed, and can draw on the error.
+func (r Result[T, E]) MapOrElse(defFn func(e E) T, fn func(data T) T) T {
+	if r.IsOk() {
+		return fn(r.ok)
+	}
+
+	return defFn(r.err)
+}
+
 // Ok returns the underlying data wrapped in Option[T].
 // If the Result is an error, an None is returned.
 func (r Result[T, E]) Ok() option.Option[T] {
 	if r.IsOk() {
-		return option.Some(*r.ok)
+		return option.Some(r.ok)
 	}
 
 	return option.None[T]()
@@ -124,14 +192,14 @@ func (r Result[T, E]) Ok() option.Option[T] {
 
 // IsOk reports whether the Result is ok.
 func (r Result[T, E]) IsOk() bool {
-	return r.ok != nil && r.err == nil
+	return r.state == okState
 }
 
 // IsOkAnd returns true if the Result is ok and the predicate
 // returns true.
 func (r Result[T, E]) IsOkAnd(fn func(data T) bool) bool {
 	if r.IsOk() {
-		return fn(*r.ok)
+		return fn(r.ok)
 	}
 
 	return false
@@ -139,14 +207,14 @@ func (r Result[T, E]) IsOkAnd(fn func(data T) bool) bool {
 
 // IsErr reports whether the Result is an error.
 func (r Result[T, E]) IsErr() bool {
-	return r.ok == nil && r.err != nil
+	return r.state == errState
 }
 
 // Err returns the underlying error wrapped in an Option[E].
 // If the Result is ok, Err returns nil.
 func (r Result[T, E]) Err() option.Option[E] {
 	if r.IsErr() {
-		return option.Some(*r.err)
+		return option.Some(r.err)
 	}
 
 	return option.None[E]()
@@ -157,11 +225,11 @@ func (r Result[T, E]) Err() option.Option[E] {
 // with `msg`. Only use this if you intend for your
 // program to crash on error or if you `recover`.
 func (r Result[T, E]) Expect(msg string) T {
-	if r.IsOk() {
-		return *r.ok
+	if !r.IsOk() {
+		panicking.Panic(msg)
 	}
 
-	panic(msg)
+	return r.ok
 }
 
 // ExpectErr is an assertion that the operation was error
@@ -170,10 +238,31 @@ func (r Result[T, E]) Expect(msg string) T {
 // program to crash on error or if you `recover`.
 func (r Result[T, E]) ExpectErr(msg string) E {
 	if !r.IsErr() {
-		panic(msg)
+		panicking.Panic(msg)
+	}
+
+	return r.err
+}
+
+// Expectf is `Expect` with a message formatted from `format` and
+// `args`, as by `fmt.Sprintf`. The message is only built on failure,
+// so the happy path pays nothing for it.
+func (r Result[T, E]) Expectf(format string, args ...any) T {
+	if !r.IsOk() {
+		panicking.Panic(fmt.Sprintf(format, args...))
+	}
+
+	return r.ok
+}
+
+// ExpectErrf is `ExpectErr` with a message formatted from `format`
+// and `args`, as by `fmt.Sprintf`.
+func (r Result[T, E]) ExpectErrf(format string, args ...any) E {
+	if !r.IsErr() {
+		panicking.Panic(fmt.Sprintf(format, args...))
 	}
 
-	return *r.err
+	return r.err
 }
 
 // Unwrap returns the underlying data. If the Result is an error,
@@ -190,12 +279,60 @@ func (r Result[T, E]) UnwrapErr() E {
 	return r.ExpectErr("called UnwrapErr an ok")
 }
 
+// UnwrapOr returns the underlying data, or `def` if the Result is an
+// error.
+func (r Result[T, E]) UnwrapOr(def T) T {
+	if r.IsOk() {
+		return r.ok
+	}
+
+	return def
+}
+
+// UnwrapOrElse returns the underlying data, or the outcome of calling
+// `fn` on the error if the Result is an error. `fn` is only called
+// when needed, so it may be costly.
+func (r Result[T, E]) UnwrapOrElse(fn func(e E) T) T {
+	if r.IsOk() {
+		return r.ok
+	}
+
+	return fn(r.err)
+}
+
+// UnwrapOrDefault returns the underlying data, or the default value
+// of `T` if the Result is an error. See `defaults.Of` for how the
+// default is chosen.
+func (r Result[T, E]) UnwrapOrDefault() T {
+	if r.IsOk() {
+		return r.ok
+	}
+
+	return defaults.Of[T]()
+}
+
+// Tuple returns the data and error of `r` as a Go-style pair, with the
+// zero value in whichever is absent. For a `Result[T, error]` that is
+// the classic `(T, error)`, nil when ok; it is the inverse of `Match`.
+func (r Result[T, E]) Tuple() (T, E) {
+	return r.ok, r.err
+}
+
 func Ok[T any](data T) Result[T, error] {
-	return Result[T, error]{ok: &data}
+	return Result[T, error]{ok: data, state: okState}
+}
+
+// OkOf wraps `data` in an ok Result with an arbitrary error type.
+// Go cannot infer `E` from `data`, so it must be given explicitly:
+// `result.OkOf[int, MyErr](42)`.
+func OkOf[T any, E any](data T) Result[T, E] {
+	return Result[T, E]{ok: data, state: okState}
 }
 
-func Err[T any, E error](e E) Result[T, E] {
-	return Result[T, E]{err: &e}
+// Err wraps `e` in an error Result. `E` is usually an `error`, but
+// any type may be used to describe the failure.
+func Err[T any, E any](e E) Result[T, E] {
+	return Result[T, E]{err: e, state: errState}
 }
 
 // Match accepts data and an error (the return from an ioutil.ReadAll, for example),
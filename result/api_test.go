@@ -0,0 +1,57 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+var (
+	sinkResult result.Result[int, string]
+	sinkInt    int
+)
+
+func double(n int) int {
+	return n * 2
+}
+
+func TestAllocs(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"OkOf", func() { sinkResult = result.OkOf[int, string](42) }},
+		{"Err", func() { sinkResult = result.Err[int]("failed") }},
+		{"Map", func() { sinkResult = result.OkOf[int, string](21).Map(double) }},
+		{"MapFunc", func() { sinkResult = result.Map(result.OkOf[int, string](21), double) }},
+		{"Unwrap", func() { sinkInt = result.OkOf[int, string](42).Unwrap() }},
+		{"UnwrapOr", func() { sinkInt = result.Err[int]("failed").UnwrapOr(0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if n := testing.AllocsPerRun(100, tt.fn); n != 0 {
+				t.Errorf("got %v allocations, want 0", n)
+			}
+		})
+	}
+}
+
+func BenchmarkOkOf(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkResult = result.OkOf[int, string](i)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		sinkResult = result.OkOf[int, string](i).Map(double)
+	}
+}
+
+func BenchmarkUnwrap(b *testing.B) {
+	r := result.OkOf[int, string](42)
+	for i := 0; i < b.N; i++ {
+		sinkInt = r.Unwrap()
+	}
+}
@@ -0,0 +1,83 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is a recovered panic. `Value` is the value passed to
+// `panic` and `Stack` is the goroutine's stack at the point of
+// recovery. `Catch` returns it, and `panics.Panic`, `task.PanicError`,
+// and `future.PanicError` are aliases of it.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", p.Value)
+}
+
+// Unwrap returns `Value` if it is an error, so `errors.Is` and
+// `errors.As` see through the panic.
+func (p *PanicError) Unwrap() error {
+	err, _ := p.Value.(error)
+	return err
+}
+
+// Catch calls `fn` and returns its value as an ok Result, or the
+// recovered panic as a `*PanicError` if `fn` panics. It is the analog
+// of Rust's `catch_unwind`. An error escaping from a `Try` without a
+// `Handle` is returned as itself rather than as a panic.
+func Catch[T any](fn func() T) (res Result[T, error]) {
+	defer func() {
+		if v := recover(); v != nil {
+			if b, ok := v.(bailout); ok {
+				if err, ok := b.err.(error); ok {
+					res = Err[T](err)
+					return
+				}
+			}
+
+			res = Err[T](error(&PanicError{Value: v, Stack: debug.Stack()}))
+		}
+	}()
+
+	return Ok(fn())
+}
+
+// CatchResult is `Catch` for a function that already returns a
+// Result: its Result is returned as is, or the recovered panic as a
+// `*PanicError` if it panics.
+func CatchResult[T any](fn func() Result[T, error]) Result[T, error] {
+	caught := Catch(fn)
+	if !caught.IsOk() {
+		return Err[T](caught.err)
+	}
+
+	return caught.ok
+}
+
+// CatchErr is `Catch` for code that panics with errors of type `E`. A
+// panic whose value is an error with an `E` in its chain is returned
+// as that `E`; any other panic is passed on unchanged.
+func CatchErr[T any, E error](fn func() T) (res Result[T, E]) {
+	defer func() {
+		if v := recover(); v != nil {
+			if b, ok := v.(bailout); ok {
+				v = b.err
+			}
+
+			var e E
+			if err, ok := v.(error); ok && errors.As(err, &e) {
+				res = Err[T](e)
+				return
+			}
+
+			panic(v)
+		}
+	}()
+
+	return OkOf[T, E](fn())
+}
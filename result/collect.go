@@ -0,0 +1,64 @@
+package result
+
+import (
+	"github.com/jwhittle933/rs.go/errorx"
+)
+
+// Collect gathers the data of every Result in `rs`, stopping at the
+// first error and returning it. See `CollectAll` to gather every
+// error instead.
+func Collect[T, E any](rs []Result[T, E]) Result[[]T, E] {
+	out := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+
+		if r.IsOk() {
+			out = append(out, r.ok)
+		}
+	}
+
+	return OkOf[[]T, E](out)
+}
+
+// CollectAll gathers the data of every Result in `rs`. Unlike a
+// short-circuiting collect, it looks at every Result: if any are
+// errors, it returns all of them joined into an `*errorx.MultiError`.
+func CollectAll[T any](rs []Result[T, error]) Result[[]T, error] {
+	out := make([]T, 0, len(rs))
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+			continue
+		}
+
+		if r.IsOk() {
+			out = append(out, r.ok)
+		}
+	}
+
+	if err := errorx.Join(errs...); err != nil {
+		return Err[[]T](err)
+	}
+
+	return Ok(out)
+}
+
+// Partition splits `rs` into the data of its ok Results and the
+// errors of its error Results, each in their original order.
+func Partition[T, E any](rs []Result[T, E]) ([]T, []E) {
+	var oks []T
+	var errs []E
+	for _, r := range rs {
+		switch r.state {
+		case okState:
+			oks = append(oks, r.ok)
+		case errState:
+			errs = append(errs, r.err)
+		}
+	}
+
+	return oks, errs
+}
@@ -0,0 +1,31 @@
+package result
+
+// Compose returns a function that calls `f` and then, if it succeeds,
+// `g` on its data. An error from `f` is returned without calling `g`.
+func Compose[A, B, C, E any](f func(A) Result[B, E], g func(B) Result[C, E]) func(A) Result[C, E] {
+	return func(a A) Result[C, E] {
+		b := f(a)
+		if !b.IsOk() {
+			return Result[C, E]{err: b.err, state: b.state}
+		}
+
+		return g(b.ok)
+	}
+}
+
+// Pipe returns a function that runs `stages` in order, each on the
+// data of the one before, stopping at the first that fails. With no
+// stages it returns its input as ok.
+func Pipe[T, E any](stages ...func(T) Result[T, E]) func(T) Result[T, E] {
+	return func(data T) Result[T, E] {
+		r := OkOf[T, E](data)
+		for _, stage := range stages {
+			r = r.AndThen(stage)
+			if !r.IsOk() {
+				break
+			}
+		}
+
+		return r
+	}
+}
@@ -0,0 +1,29 @@
+package result
+
+// Equal reports whether `a` and `b` are both ok with equal data, both
+// errors with equal errors, or both the zero Result. It compares with
+// ==, so an error interface compares by identity.
+func Equal[T, E comparable](a, b Result[T, E]) bool {
+	return EqualFunc(a, b,
+		func(x, y T) bool { return x == y },
+		func(x, y E) bool { return x == y },
+	)
+}
+
+// EqualFunc is `Equal` for types that are not comparable, or that need
+// a looser equality, such as errors compared with `errors.Is`. `eqOk`
+// compares data and `eqErr` compares errors.
+func EqualFunc[T, U, E, F any](a Result[T, E], b Result[U, F], eqOk func(T, U) bool, eqErr func(E, F) bool) bool {
+	if a.state != b.state {
+		return false
+	}
+
+	switch a.state {
+	case okState:
+		return eqOk(a.ok, b.ok)
+	case errState:
+		return eqErr(a.err, b.err)
+	default:
+		return true
+	}
+}
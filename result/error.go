@@ -0,0 +1,43 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AsError returns the error of `r` as an `error`, or nil if `r` is not
+// an error. It is the way to hand a Result to code expecting a plain
+// `error`, and to wrap it with `%w`.
+//
+// Result does not implement `error` itself: its `Unwrap` returns the
+// ok data, which clashes with the `Unwrap() error` of error chains,
+// and an ok Result would be a non-nil error.
+//
+// An error of a type that does not implement `error` is wrapped in a
+// new error printing it with `%v`.
+func (r Result[T, E]) AsError() error {
+	if !r.IsErr() {
+		return nil
+	}
+
+	if err, ok := any(r.err).(error); ok {
+		return err
+	}
+
+	return fmt.Errorf("%v", r.err)
+}
+
+// Is reports whether `r` is an error matching `target`, as decided by
+// `errors.Is`.
+func (r Result[T, E]) Is(target error) bool {
+	err := r.AsError()
+	return err != nil && errors.Is(err, target)
+}
+
+// As reports whether `r` is an error with an error in its chain
+// matching `target`, and if so sets `target` to it, as `errors.As`
+// does.
+func (r Result[T, E]) As(target any) bool {
+	err := r.AsError()
+	return err != nil && errors.As(err, target)
+}
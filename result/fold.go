@@ -0,0 +1,25 @@
+package result
+
+// Fold returns `okFn` applied to the data of `r` if it is ok, and
+// `errFn` applied to its error otherwise, so both branches are handled
+// in one expression. The zero Result takes the error branch with the
+// zero `E`.
+func Fold[T, E, U any](r Result[T, E], okFn func(T) U, errFn func(E) U) U {
+	if r.IsOk() {
+		return okFn(r.ok)
+	}
+
+	return errFn(r.err)
+}
+
+// MatchWith calls `okFn` on the data of `r` if it is ok, and `errFn`
+// on its error otherwise. It is `Fold` for callbacks run only for
+// their side effects.
+func MatchWith[T, E any](r Result[T, E], okFn func(T), errFn func(E)) {
+	if r.IsOk() {
+		okFn(r.ok)
+		return
+	}
+
+	errFn(r.err)
+}
@@ -0,0 +1,46 @@
+package result
+
+import (
+	"fmt"
+)
+
+// String returns "Ok(data)" or "Err(error)", with the contents printed
+// by `%v`, or "Result()" for the zero Result.
+func (r Result[T, E]) String() string {
+	return fmt.Sprintf("%v", r)
+}
+
+// GoString returns "result.Ok(data)" or "result.Err(error)", with the
+// contents printed by `%#v`, or "result.Result{}" for the zero Result.
+// It is used by the `%#v` verb.
+func (r Result[T, E]) GoString() string {
+	switch r.state {
+	case okState:
+		return fmt.Sprintf("result.Ok(%#v)", r.ok)
+	case errState:
+		return fmt.Sprintf("result.Err(%#v)", r.err)
+	default:
+		return "result.Result{}"
+	}
+}
+
+// Format implements `fmt.Formatter`, printing a Result as "Ok(data)"
+// or "Err(error)", like Rust's debug output. The verb and its flags
+// apply to the contents, so `%+v` and `%q` reach the data or error;
+// `%#v` prints the GoString.
+func (r Result[T, E]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprint(f, r.GoString())
+		return
+	}
+
+	format := fmt.FormatString(f, verb)
+	switch r.state {
+	case okState:
+		fmt.Fprintf(f, "Ok("+format+")", r.ok)
+	case errState:
+		fmt.Fprintf(f, "Err("+format+")", r.err)
+	default:
+		fmt.Fprint(f, "Result()")
+	}
+}
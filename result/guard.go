@@ -0,0 +1,22 @@
+package result
+
+// Guard returns `ptr` as ok if it is not nil, and `err` otherwise. It
+// is `option.Guard` for code that needs to say why a value is missing.
+func Guard[T, E any](ptr *T, err E) Result[*T, E] {
+	if ptr == nil {
+		return Err[*T](err)
+	}
+
+	return OkOf[*T, E](ptr)
+}
+
+// Then calls `fn` on the pointer in `r` and guards the pointer it
+// returns, failing with `err` if it is nil. An error in `r` is passed
+// through unchanged.
+func Then[A, B, E any](r Result[*A, E], fn func(*A) *B, err E) Result[*B, E] {
+	if !r.IsOk() {
+		return Result[*B, E]{err: r.err, state: r.state}
+	}
+
+	return Guard(fn(r.ok), err)
+}
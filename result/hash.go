@@ -0,0 +1,37 @@
+package result
+
+import (
+	"fmt"
+	"io"
+)
+
+// Key returns a canonical string for `r`: "Ok(v)" or "Err(e)" with the
+// value printed in Go syntax, or "Result()" for the zero Result. An
+// error is printed as its quoted `Error()` message, so Results failing
+// with the same message get equal Keys.
+//
+// A Result of comparable types is itself comparable and can be used
+// directly as a map key, but errors usually compare by identity: two
+// `errors.New("x")` are not ==. Use Key when errors should match by
+// message, or when `T` or `E` is not comparable.
+func (r Result[T, E]) Key() string {
+	switch r.state {
+	case okState:
+		return fmt.Sprintf("Ok(%#v)", r.ok)
+	case errState:
+		if err, ok := any(r.err).(error); ok && err != nil {
+			return fmt.Sprintf("Err(%q)", err.Error())
+		}
+
+		return fmt.Sprintf("Err(%#v)", r.err)
+	default:
+		return "Result()"
+	}
+}
+
+// Hash writes the Key of `r` to `h`, typically a `hash.Hash` or a
+// `*maphash.Hash`, so a Result can be folded into the hash of a
+// larger value.
+func (r Result[T, E]) Hash(h io.Writer) {
+	_, _ = io.WriteString(h, r.Key())
+}
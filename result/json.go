@@ -0,0 +1,91 @@
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// jsonResult is the tagged encoding of a Result. Exactly one field is
+// set; the zero Result encodes as `null`.
+type jsonResult struct {
+	Ok  json.RawMessage `json:"ok,omitempty"`
+	Err json.RawMessage `json:"err,omitempty"`
+}
+
+// MarshalJSON encodes an ok Result as `{"ok": data}` and an error
+// Result as `{"err": error}`. An error that implements `error` but not
+// `json.Marshaler` is encoded as its message string, since most error
+// types have no exported fields. The zero Result encodes as `null`.
+func (r Result[T, E]) MarshalJSON() ([]byte, error) {
+	switch r.state {
+	case okState:
+		ok, err := json.Marshal(r.ok)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(jsonResult{Ok: ok})
+	case errState:
+		var v any = r.err
+		if e, isErr := v.(error); isErr {
+			if _, custom := v.(json.Marshaler); !custom {
+				v = e.Error()
+			}
+		}
+
+		e, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(jsonResult{Err: e})
+	default:
+		return []byte("null"), nil
+	}
+}
+
+// UnmarshalJSON decodes the encoding written by MarshalJSON. When `E`
+// is the `error` interface, the message string is decoded into a new
+// error with that message; identity and concrete type are not
+// preserved. Other error types are decoded as ordinary JSON values.
+func (r *Result[T, E]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*r = Result[T, E]{}
+		return nil
+	}
+
+	var tagged jsonResult
+	if err := json.Unmarshal(data, &tagged); err != nil {
+		return err
+	}
+
+	switch {
+	case tagged.Ok != nil && tagged.Err == nil:
+		var ok T
+		if err := json.Unmarshal(tagged.Ok, &ok); err != nil {
+			return err
+		}
+
+		*r = Result[T, E]{ok: ok, state: okState}
+	case tagged.Err != nil && tagged.Ok == nil:
+		var e E
+		if target, isErr := any(&e).(*error); isErr {
+			var msg string
+			if err := json.Unmarshal(tagged.Err, &msg); err != nil {
+				return err
+			}
+
+			*target = errors.New(msg)
+		} else if err := json.Unmarshal(tagged.Err, &e); err != nil {
+			return err
+		}
+
+		*r = Result[T, E]{err: e, state: errState}
+	default:
+		return fmt.Errorf("result: JSON object must have exactly one of \"ok\" or \"err\": %s", data)
+	}
+
+	return nil
+}
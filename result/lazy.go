@@ -0,0 +1,51 @@
+package result
+
+// Lazy is a Result that has not been computed yet. Its methods build
+// up a computation, as the Result methods of the same names would,
+// that runs only when `Eval` is called, and again on every call. The
+// zero Lazy evaluates to the zero Result.
+type Lazy[T, E any] struct {
+	run func() Result[T, E]
+}
+
+// Defer returns a Lazy that computes its Result by calling `fn`.
+func Defer[T, E any](fn func() Result[T, E]) Lazy[T, E] {
+	return Lazy[T, E]{run: fn}
+}
+
+// Eval runs the computation and returns its Result.
+func (l Lazy[T, E]) Eval() Result[T, E] {
+	if l.run == nil {
+		return Result[T, E]{}
+	}
+
+	return l.run()
+}
+
+// Map returns a Lazy that applies `fn` to the data once evaluated.
+func (l Lazy[T, E]) Map(fn func(data T) T) Lazy[T, E] {
+	return Defer(func() Result[T, E] { return l.Eval().Map(fn) })
+}
+
+// MapErr returns a Lazy that applies `fn` to the error once
+// evaluated.
+func (l Lazy[T, E]) MapErr(fn func(e E) E) Lazy[T, E] {
+	return Defer(func() Result[T, E] { return l.Eval().MapErr(fn) })
+}
+
+// AndThen returns a Lazy that calls `fn` on the data once evaluated,
+// if it is ok.
+func (l Lazy[T, E]) AndThen(fn func(data T) Result[T, E]) Lazy[T, E] {
+	return Defer(func() Result[T, E] { return l.Eval().AndThen(fn) })
+}
+
+// OrElse returns a Lazy that calls `fn` on the error once evaluated,
+// if it is an error.
+func (l Lazy[T, E]) OrElse(fn func(e E) Result[T, E]) Lazy[T, E] {
+	return Defer(func() Result[T, E] { return l.Eval().OrElse(fn) })
+}
+
+// LazyMap is the type-changing `Map` function for a Lazy.
+func LazyMap[T, U, E any](l Lazy[T, E], fn func(T) U) Lazy[U, E] {
+	return Defer(func() Result[U, E] { return Map(l.Eval(), fn) })
+}
@@ -0,0 +1,36 @@
+package result
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"time"
+)
+
+// LogErr logs the error with `logger` at error level if `r` is an
+// error, and returns `r` unchanged either way, so it can sit anywhere
+// in a chain. The record's source location is the caller of LogErr,
+// and the error is attached under the "error" key. A nil `logger`
+// uses `slog.Default()`.
+func (r Result[T, E]) LogErr(logger *slog.Logger, msg string) Result[T, E] {
+	if !r.IsErr() {
+		return r
+	}
+
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	ctx := context.Background()
+	if !logger.Enabled(ctx, slog.LevelError) {
+		return r
+	}
+
+	var pcs [1]uintptr
+	runtime.Callers(2, pcs[:])
+	rec := slog.NewRecord(time.Now(), slog.LevelError, msg, pcs[0])
+	rec.AddAttrs(slog.Any("error", r.err))
+	_ = logger.Handler().Handle(ctx, rec)
+
+	return r
+}
@@ -0,0 +1,89 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ExitCoder is implemented by errors that choose the exit status of
+// the process when they reach `MainErr`.
+type ExitCoder interface {
+	ExitCode() int
+}
+
+type mainConfig struct {
+	code      int
+	backtrace bool
+	stderr    io.Writer
+}
+
+// MainOption configures `MainErr`.
+type MainOption func(*mainConfig)
+
+// WithExitCode sets the exit status used when the error does not
+// implement `ExitCoder`. The default is 1.
+func WithExitCode(code int) MainOption {
+	return func(c *mainConfig) { c.code = code }
+}
+
+// WithBacktrace prints the error with `%+v`, which includes the stack
+// for errors that record one, such as those from the `errorx` package.
+func WithBacktrace() MainOption {
+	return func(c *mainConfig) { c.backtrace = true }
+}
+
+// WithStderr sets where the error is printed. The default is `os.Stderr`.
+func WithStderr(w io.Writer) MainOption {
+	return func(c *mainConfig) { c.stderr = w }
+}
+
+// MainErr runs `fn`, the body of a program, in the manner of Rust's
+// `fn main() -> Result<(), E>`. If `fn` returns ok, MainErr returns
+// normally. Otherwise it prints the error and its chain of causes to
+// stderr and exits the process.
+//
+//	func main() {
+//		result.MainErr(run)
+//	}
+func MainErr(fn func() Result[struct{}, error], opts ...MainOption) {
+	c := mainConfig{code: 1, stderr: os.Stderr}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	res := fn()
+	if !res.IsErr() {
+		return
+	}
+
+	err := res.err
+	if c.backtrace {
+		fmt.Fprintf(c.stderr, "Error: %+v\n", err)
+	} else {
+		fmt.Fprintf(c.stderr, "Error: %v\n", err)
+		printCauses(c.stderr, err)
+	}
+
+	code := c.code
+	var coder ExitCoder
+	if errors.As(err, &coder) {
+		code = coder.ExitCode()
+	}
+
+	os.Exit(code)
+}
+
+func printCauses(w io.Writer, err error) {
+	cause := errors.Unwrap(err)
+	if cause == nil {
+		return
+	}
+
+	fmt.Fprintln(w, "\nCaused by:")
+	for i := 0; cause != nil; i++ {
+		fmt.Fprintf(w, "    %d: %v\n", i, cause)
+		cause = errors.Unwrap(cause)
+	}
+}
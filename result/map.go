@@ -0,0 +1,24 @@
+package result
+
+// Map calls `fn` on the data of `r` and returns the outcome as an ok
+// Result. Unlike the `Map` method, `fn` may return a different type.
+// An error in `r` is passed through unchanged and `fn` is not called.
+func Map[T, U, E any](r Result[T, E], fn func(T) U) Result[U, E] {
+	if !r.IsOk() {
+		return Result[U, E]{err: r.err, state: r.state}
+	}
+
+	return Result[U, E]{ok: fn(r.ok), state: okState}
+}
+
+// MapErr calls `fn` on the error of `r` and returns the outcome as an
+// error Result, for converting between error types. Unlike the
+// `MapErr` method, `fn` may return a different type. An ok `r` is
+// passed through unchanged and `fn` is not called.
+func MapErr[T, E, F any](r Result[T, E], fn func(E) F) Result[T, F] {
+	if !r.IsErr() {
+		return Result[T, F]{ok: r.ok, state: r.state}
+	}
+
+	return Result[T, F]{err: fn(r.err), state: errState}
+}
@@ -0,0 +1,77 @@
+package result
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// OnceMode controls how a `OnceResult` treats a failed computation.
+type OnceMode int
+
+const (
+	// CacheErr keeps the first Result, ok or error. This is the zero
+	// value and mirrors `sync.Once`.
+	CacheErr OnceMode = iota
+	// RetryOnErr keeps only an ok Result. An error is returned to the
+	// caller and the next call runs the function again.
+	RetryOnErr
+)
+
+// OnceResult is a fallible computation run at most once, such as
+// opening a database pool or dialing a client. Create one with `Once`
+// or `OnceRetry`; the zero value is also usable with `Do`, and caches errors. It is
+// safe for concurrent use and must not be copied after first use.
+type OnceResult[T, E any] struct {
+	fn   func() Result[T, E]
+	mode OnceMode
+	mu   sync.Mutex
+	done atomic.Bool
+	res  Result[T, E]
+}
+
+// Once returns a OnceResult that calls `fn` on the first `Get` and
+// keeps its Result, ok or error, for later ones.
+func Once[T, E any](fn func() Result[T, E]) *OnceResult[T, E] {
+	return &OnceResult[T, E]{fn: fn, mode: CacheErr}
+}
+
+// OnceRetry is like `Once`, but keeps only an ok Result. After an
+// error, the next `Get` calls `fn` again.
+func OnceRetry[T, E any](fn func() Result[T, E]) *OnceResult[T, E] {
+	return &OnceResult[T, E]{fn: fn, mode: RetryOnErr}
+}
+
+// Get is `Do` with the function given to `Once`.
+func (o *OnceResult[T, E]) Get() Result[T, E] {
+	return o.Do(o.fn)
+}
+
+// Do returns the kept Result, calling `fn` first if there is none yet.
+// Concurrent callers wait for the running call. If `fn` panics, the
+// panic is passed on and the next call runs again.
+func (o *OnceResult[T, E]) Do(fn func() Result[T, E]) Result[T, E] {
+	if o.done.Load() {
+		return o.res
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.done.Load() {
+		return o.res
+	}
+
+	r := fn()
+	if r.IsErr() && o.mode == RetryOnErr {
+		return r
+	}
+
+	o.res = r
+	o.done.Store(true)
+	return r
+}
+
+// Done reports whether a Result has been kept.
+func (o *OnceResult[T, E]) Done() bool {
+	return o.done.Load()
+}
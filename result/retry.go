@@ -0,0 +1,70 @@
+package result
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff returns how long `Retry` waits before retry number
+// `attempt`, counting from 1.
+type Backoff func(attempt int) time.Duration
+
+// Constant returns a Backoff that always waits `d`.
+func Constant(d time.Duration) Backoff {
+	return func(int) time.Duration { return d }
+}
+
+// Exponential returns a Backoff that waits `base` before the first
+// retry and doubles the wait for each retry after, up to `max`.
+func Exponential(base, max time.Duration) Backoff {
+	return func(attempt int) time.Duration {
+		d := base
+		for i := 1; i < attempt && d < max; i++ {
+			d *= 2
+		}
+
+		return min(d, max)
+	}
+}
+
+// Jitter returns a Backoff that waits a random duration between zero
+// and what `b` would wait, so that many clients retrying at once
+// spread out.
+func Jitter(b Backoff) Backoff {
+	return func(attempt int) time.Duration {
+		d := b(attempt)
+		if d <= 0 {
+			return 0
+		}
+
+		return time.Duration(rand.Int63n(int64(d) + 1))
+	}
+}
+
+// Retry calls `fn` until it returns an ok Result or it has been called
+// `attempts` times, waiting as `backoff` says between calls, and
+// returns the last Result. A nil `backoff` retries immediately, and
+// `attempts` below 1 is treated as 1.
+func Retry[T, E any](attempts int, backoff Backoff, fn func() Result[T, E]) Result[T, E] {
+	return RetryIf(attempts, backoff, nil, fn)
+}
+
+// RetryIf is `Retry` that gives up early on an error for which
+// `retryIf` returns false, such as `httpx.Retryable` for HTTP calls. A
+// nil `retryIf` retries every error.
+func RetryIf[T, E any](attempts int, backoff Backoff, retryIf func(E) bool, fn func() Result[T, E]) Result[T, E] {
+	r := fn()
+	for attempt := 1; attempt < attempts && !r.IsOk(); attempt++ {
+		if retryIf != nil && !retryIf(r.err) {
+			break
+		}
+
+		if backoff != nil {
+			time.Sleep(backoff(attempt))
+		}
+
+		r = fn()
+	}
+
+	return r
+}
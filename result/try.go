@@ -0,0 +1,68 @@
+package result
+
+import (
+	"fmt"
+)
+
+// bailout is the panic value `Try` uses to carry an error to `Handle`.
+// It is an error itself so that, if recovered by other code, its
+// message and cause are not lost.
+type bailout struct {
+	err any
+}
+
+func (b bailout) Error() string {
+	return fmt.Sprintf("result: Try without Handle: %v", b.err)
+}
+
+func (b bailout) Unwrap() error {
+	err, _ := b.err.(error)
+	return err
+}
+
+// Try returns the data of `r` if it is ok. Otherwise it panics with a
+// private value carrying the error, which the `Handle` deferred by the
+// enclosing function turns back into its error Result. It emulates
+// Rust's `?` operator:
+//
+//	func load(path string) (res result.Result[Config, error]) {
+//		defer result.Handle(&res)
+//
+//		data := result.Try(fs.ReadFile(path))
+//		return result.Ok(result.Try(parse(data)))
+//	}
+//
+// Calling Try without a matching Handle crashes the program like any
+// other unrecovered panic.
+func Try[T, E any](r Result[T, E]) T {
+	if !r.IsOk() {
+		panic(bailout{err: r.err})
+	}
+
+	return r.ok
+}
+
+// Handle must be deferred directly, as `defer result.Handle(&res)`.
+// It recovers a panic raised by `Try` and stores its error in `*ret`
+// as an error Result. The error from Try must be assignable to `E`;
+// any other panic is passed on unchanged.
+func Handle[T, E any](ret *Result[T, E]) {
+	v := recover()
+	if v == nil {
+		return
+	}
+
+	b, ok := v.(bailout)
+	if !ok {
+		panic(v)
+	}
+
+	var e E
+	if b.err != nil {
+		if e, ok = b.err.(E); !ok {
+			panic(v)
+		}
+	}
+
+	*ret = Err[T](e)
+}
@@ -0,0 +1,31 @@
+package result
+
+// Wrap0 lifts a function returning `(T, error)` into one returning a
+// Result, so it can be chained: `result.Wrap0(os.Getwd)`.
+func Wrap0[T any](fn func() (T, error)) func() Result[T, error] {
+	return func() Result[T, error] {
+		return Match(fn())
+	}
+}
+
+// Wrap1 is `Wrap0` for functions of one argument:
+// `atoi := result.Wrap1(strconv.Atoi)`.
+func Wrap1[A, T any](fn func(A) (T, error)) func(A) Result[T, error] {
+	return func(a A) Result[T, error] {
+		return Match(fn(a))
+	}
+}
+
+// Wrap2 is `Wrap0` for functions of two arguments.
+func Wrap2[A, B, T any](fn func(A, B) (T, error)) func(A, B) Result[T, error] {
+	return func(a A, b B) Result[T, error] {
+		return Match(fn(a, b))
+	}
+}
+
+// Wrap3 is `Wrap0` for functions of three arguments.
+func Wrap3[A, B, C, T any](fn func(A, B, C) (T, error)) func(A, B, C) Result[T, error] {
+	return func(a A, b B, c C) Result[T, error] {
+		return Match(fn(a, b, c))
+	}
+}
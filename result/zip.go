@@ -0,0 +1,33 @@
+package result
+
+import (
+	"github.com/jwhittle933/rs.go/tuple"
+)
+
+// Zip combines the data of `a` and `b` into an ok Pair if both are
+// ok. Otherwise it returns the first of them that is not ok.
+func Zip[A, B, E any](a Result[A, E], b Result[B, E]) Result[tuple.Pair[A, B], E] {
+	if !a.IsOk() {
+		return Result[tuple.Pair[A, B], E]{err: a.err, state: a.state}
+	}
+
+	if !b.IsOk() {
+		return Result[tuple.Pair[A, B], E]{err: b.err, state: b.state}
+	}
+
+	return OkOf[tuple.Pair[A, B], E](tuple.NewPair(a.ok, b.ok))
+}
+
+// Zip3 is `Zip` for three Results.
+func Zip3[A, B, C, E any](a Result[A, E], b Result[B, E], c Result[C, E]) Result[tuple.Triple[A, B, C], E] {
+	ab := Zip(a, b)
+	if !ab.IsOk() {
+		return Result[tuple.Triple[A, B, C], E]{err: ab.err, state: ab.state}
+	}
+
+	if !c.IsOk() {
+		return Result[tuple.Triple[A, B, C], E]{err: c.err, state: c.state}
+	}
+
+	return OkOf[tuple.Triple[A, B, C], E](tuple.NewTriple(a.ok, b.ok, c.ok))
+}
@@ -0,0 +1,21 @@
+// Package rs holds settings that apply across the `option` and
+// `result` packages.
+package rs
+
+import "github.com/jwhittle933/rs.go/internal/panicking"
+
+// Frame is a single function call in the stack passed to an unwrap
+// handler.
+type Frame = panicking.Frame
+
+// SetUnwrapHandler installs `h` to be called by `Expect`, `Unwrap`,
+// and their variants on `Option` and `Result` just before they panic.
+// `stack` starts at the code that called the failing method. The
+// handler may log, record metrics, or end the process with `os.Exit`;
+// if it returns, the panic goes ahead as usual. A nil `h` restores the
+// default of panicking alone. It is safe to call concurrently with
+// unwrapping, but is meant to be called once, early in `main` or in a
+// test.
+func SetUnwrapHandler(h func(msg string, stack []Frame)) {
+	panicking.SetHandler(h)
+}
@@ -0,0 +1,100 @@
+// Package rscmp provides `go-cmp` options for comparing values that
+// contain `Option` and `Result`. Their fields are unexported, so
+// without these options `cmp.Equal` and `cmp.Diff` panic on them.
+//
+//	if diff := cmp.Diff(want, got, rscmp.Options()); diff != "" {
+//		t.Errorf("mismatch (-want +got):\n%s", diff)
+//	}
+//
+// It is a separate module so that the core packages do not depend on
+// `go-cmp`.
+package rscmp
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	optionPath = "github.com/jwhittle933/rs.go/option"
+	resultPath = "github.com/jwhittle933/rs.go/result"
+)
+
+// Some is how an `Option` holding a value appears in a diff.
+type Some struct {
+	Value any
+}
+
+// None is how an empty `Option` appears in a diff.
+type None struct{}
+
+// Ok is how an ok `Result` appears in a diff.
+type Ok struct {
+	Value any
+}
+
+// Err is how an error `Result` appears in a diff. For a `Result` whose
+// error type implements `error`, `Value` is the error's message, so two
+// errors are equal when their messages are.
+type Err struct {
+	Value any
+}
+
+// Options returns options that compare an `Option` by whether it holds
+// a value and then by the value, and a `Result` by its variant and
+// then by its value or error. A zero `Result`, which is neither,
+// appears as nil. Values are compared with the other
+// options passed to `cmp`, so nested Options and Results work too.
+func Options() cmp.Option {
+	return cmp.Options{
+		cmp.FilterPath(func(p cmp.Path) bool {
+			return is(p.Last().Type(), optionPath, "Option[")
+		}, cmp.Transformer("Option", transformOption)),
+		cmp.FilterPath(func(p cmp.Path) bool {
+			return is(p.Last().Type(), resultPath, "Result[")
+		}, cmp.Transformer("Result", transformResult)),
+	}
+}
+
+func is(t reflect.Type, path, prefix string) bool {
+	return t != nil && t.PkgPath() == path && strings.HasPrefix(t.Name(), prefix)
+}
+
+func transformOption(o any) any {
+	v := reflect.ValueOf(o)
+	if call(v, "IsNone").Bool() {
+		return None{}
+	}
+
+	return Some{Value: call(v, "Unwrap").Interface()}
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+func transformResult(r any) any {
+	v := reflect.ValueOf(r)
+	if call(v, "IsOk").Bool() {
+		return Ok{Value: call(v, "Unwrap").Interface()}
+	}
+
+	if !call(v, "IsErr").Bool() {
+		return nil
+	}
+
+	e := call(v, "UnwrapErr")
+	if e.Type().Implements(errorType) {
+		if e.Kind() == reflect.Interface && e.IsNil() {
+			return Err{}
+		}
+
+		return Err{Value: e.Interface().(error).Error()}
+	}
+
+	return Err{Value: e.Interface()}
+}
+
+func call(v reflect.Value, method string) reflect.Value {
+	return v.MethodByName(method).Call(nil)[0]
+}
@@ -0,0 +1,8 @@
+module github.com/jwhittle933/rs.go/rscmp
+
+go 1.21
+
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/jwhittle933/rs.go v0.0.0-20261015071530-29a2cb77310e
+)
@@ -0,0 +1,123 @@
+// Package slice provides helpers for plain Go slices that return
+// `Option`s and `Result`s instead of panicking or returning
+// sentinel values. Use the `vec` package for an owned, growable list.
+package slice
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Get returns `s[i]`, or None if `i` is out of range.
+func Get[T any](s []T, i int) option.Option[T] {
+	if i < 0 || i >= len(s) {
+		return option.None[T]()
+	}
+
+	return option.Some(s[i])
+}
+
+// First returns the first element of `s`, or None if `s` is empty.
+func First[T any](s []T) option.Option[T] {
+	return Get(s, 0)
+}
+
+// Last returns the last element of `s`, or None if `s` is empty.
+func Last[T any](s []T) option.Option[T] {
+	return Get(s, len(s)-1)
+}
+
+// Find returns the first element of `s` for which `pred` returns
+// true, or None if there is no such element.
+func Find[T any](s []T, pred func(T) bool) option.Option[T] {
+	for _, v := range s {
+		if pred(v) {
+			return option.Some(v)
+		}
+	}
+
+	return option.None[T]()
+}
+
+// Position returns the index of the first element of `s` for which
+// `pred` returns true, or None if there is no such element.
+func Position[T any](s []T, pred func(T) bool) option.Option[int] {
+	for i, v := range s {
+		if pred(v) {
+			return option.Some(i)
+		}
+	}
+
+	return option.None[int]()
+}
+
+// FindMap calls `fn` on each element of `s` in order and returns
+// the first Some it produces, or None if every call returns None.
+func FindMap[T any, U any](s []T, fn func(T) option.Option[U]) option.Option[U] {
+	for _, v := range s {
+		if found := fn(v); found.IsSome() {
+			return found
+		}
+	}
+
+	return option.None[U]()
+}
+
+// BinarySearch searches the sorted slice `s` for `target`. If it is
+// found, the ok Result holds its index. Otherwise the error Result
+// holds the index at which `target` could be inserted to keep `s`
+// sorted. If several elements match, any one of their indexes may
+// be returned.
+func BinarySearch[T cmp.Ordered](s []T, target T) result.Result[int, int] {
+	return BinarySearchFunc(s, target, cmp.Compare[T])
+}
+
+// BinarySearchFunc is like `BinarySearch`, but uses `compare` to order
+// elements against the target. `compare` must return a negative number
+// when the element sorts before the target, zero when they match, and
+// a positive number when it sorts after. `s` must be sorted in the
+// order `compare` describes.
+func BinarySearchFunc[T any, U any](s []T, target U, compare func(T, U) int) result.Result[int, int] {
+	i, found := slices.BinarySearchFunc(s, target, compare)
+	if !found {
+		return result.Err[int](i)
+	}
+
+	return result.OkOf[int, int](i)
+}
+
+// TryMap calls `fn` on each element of `s` in order and collects the
+// outputs. It stops at the first error and returns it, discarding the
+// outputs gathered so far.
+func TryMap[T any, U any](s []T, fn func(T) (U, error)) result.Result[[]U, error] {
+	out := make([]U, 0, len(s))
+	for _, v := range s {
+		u, err := fn(v)
+		if err != nil {
+			return result.Err[[]U](err)
+		}
+
+		out = append(out, u)
+	}
+
+	return result.Ok(out)
+}
+
+// TryMapResult is like `TryMap` for functions that already return a
+// Result. It stops at the first error Result and returns its error.
+func TryMapResult[T any, U any, E any](s []T, fn func(T) result.Result[U, E]) result.Result[[]U, E] {
+	out := make([]U, 0, len(s))
+	for _, v := range s {
+		r := fn(v)
+		if r.IsErr() {
+			return result.Err[[]U](r.UnwrapErr())
+		}
+
+		out = append(out, r.Unwrap())
+	}
+
+	return result.OkOf[[]U, E](out)
+}
@@ -0,0 +1,59 @@
+package slice
+
+import (
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Windows returns an Iterator over every contiguous window of
+// length `n` in `s`, advancing one element at a time. Each window
+// is a subslice of `s`, not a copy. If `n` is not positive or is
+// larger than `len(s)`, the Iterator yields nothing.
+func Windows[T any](s []T, n int) iter.Iterator[[]T] {
+	i := 0
+	return iter.Func[[]T](func() option.Option[[]T] {
+		if n <= 0 || i+n > len(s) {
+			return option.None[[]T]()
+		}
+
+		i++
+		return option.Some(s[i-1 : i-1+n : i-1+n])
+	})
+}
+
+// Chunks is an Iterator over non-overlapping chunks of exactly
+// `n` elements. Elements left over at the end are available from
+// `Remainder`.
+type Chunks[T any] struct {
+	s []T
+	n int
+}
+
+// ChunksExact returns an Iterator over consecutive chunks of length
+// `n` in `s`. Each chunk is a subslice of `s`, not a copy. If `n` is
+// not positive, the Iterator yields nothing and the remainder is `s`.
+func ChunksExact[T any](s []T, n int) *Chunks[T] {
+	return &Chunks[T]{s: s, n: n}
+}
+
+// Next returns the next full chunk, or None once fewer than `n`
+// elements remain.
+func (c *Chunks[T]) Next() option.Option[[]T] {
+	if c.n <= 0 || len(c.s) < c.n {
+		return option.None[[]T]()
+	}
+
+	chunk := c.s[:c.n:c.n]
+	c.s = c.s[c.n:]
+	return option.Some(chunk)
+}
+
+// Remainder returns the trailing elements that do not fill a whole
+// chunk. It does not depend on how far the Iterator has advanced.
+func (c *Chunks[T]) Remainder() []T {
+	if c.n <= 0 {
+		return c.s
+	}
+
+	return c.s[len(c.s)-len(c.s)%c.n:]
+}
@@ -0,0 +1,91 @@
+package slice
+
+import (
+	"cmp"
+
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Max returns the largest element of `s`, or None if `s` is empty.
+// If several elements are equally large, the last one is returned.
+func Max[T cmp.Ordered](s []T) option.Option[T] {
+	return MaxBy(s, cmp.Compare[T])
+}
+
+// Min returns the smallest element of `s`, or None if `s` is empty.
+// If several elements are equally small, the first one is returned.
+func Min[T cmp.Ordered](s []T) option.Option[T] {
+	return MinBy(s, cmp.Compare[T])
+}
+
+// MaxBy is like `Max`, but orders elements with `compare`, which
+// returns a negative number, zero, or a positive number when `a`
+// sorts before, with, or after `b`.
+func MaxBy[T any](s []T, compare func(a, b T) int) option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+
+	best := s[0]
+	for _, v := range s[1:] {
+		if compare(v, best) >= 0 {
+			best = v
+		}
+	}
+
+	return option.Some(best)
+}
+
+// MinBy is like `Min`, but orders elements with `compare`, which
+// returns a negative number, zero, or a positive number when `a`
+// sorts before, with, or after `b`.
+func MinBy[T any](s []T, compare func(a, b T) int) option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+
+	best := s[0]
+	for _, v := range s[1:] {
+		if compare(v, best) < 0 {
+			best = v
+		}
+	}
+
+	return option.Some(best)
+}
+
+// MaxByKey returns the element of `s` for which `key` returns the
+// largest value, or None if `s` is empty. Ties go to the last element.
+// `key` is called once per element.
+func MaxByKey[T any, K cmp.Ordered](s []T, key func(T) K) option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+
+	best, bestKey := s[0], key(s[0])
+	for _, v := range s[1:] {
+		if k := key(v); cmp.Compare(k, bestKey) >= 0 {
+			best, bestKey = v, k
+		}
+	}
+
+	return option.Some(best)
+}
+
+// MinByKey returns the element of `s` for which `key` returns the
+// smallest value, or None if `s` is empty. Ties go to the first element.
+// `key` is called once per element.
+func MinByKey[T any, K cmp.Ordered](s []T, key func(T) K) option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+
+	best, bestKey := s[0], key(s[0])
+	for _, v := range s[1:] {
+		if k := key(v); cmp.Compare(k, bestKey) < 0 {
+			best, bestKey = v, k
+		}
+	}
+
+	return option.Some(best)
+}
@@ -0,0 +1,91 @@
+// Package str provides string helpers that return `Option`s in place
+// of the -1 and bool sentinels used by the `strings` package, loosely
+// modeled on Rust's `str` methods.
+package str
+
+import (
+	"strings"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/tuple"
+)
+
+// StripPrefix returns `s` without `prefix`, or None if `s` does not
+// start with `prefix`.
+func StripPrefix(s, prefix string) option.Option[string] {
+	if rest, ok := strings.CutPrefix(s, prefix); ok {
+		return option.Some(rest)
+	}
+
+	return option.None[string]()
+}
+
+// StripSuffix returns `s` without `suffix`, or None if `s` does not
+// end with `suffix`.
+func StripSuffix(s, suffix string) option.Option[string] {
+	if rest, ok := strings.CutSuffix(s, suffix); ok {
+		return option.Some(rest)
+	}
+
+	return option.None[string]()
+}
+
+// Find returns the byte index of the first occurrence of `substr` in
+// `s`, or None if it does not occur.
+func Find(s, substr string) option.Option[int] {
+	return index(strings.Index(s, substr))
+}
+
+// RFind returns the byte index of the last occurrence of `substr` in
+// `s`, or None if it does not occur.
+func RFind(s, substr string) option.Option[int] {
+	return index(strings.LastIndex(s, substr))
+}
+
+func index(i int) option.Option[int] {
+	if i < 0 {
+		return option.None[int]()
+	}
+
+	return option.Some(i)
+}
+
+// CharAt returns the `i`th rune of `s`, counting runes rather than
+// bytes, or None if `s` has fewer than `i+1` runes. Invalid UTF-8
+// bytes each count as one `utf8.RuneError`.
+func CharAt(s string, i int) option.Option[rune] {
+	if i < 0 {
+		return option.None[rune]()
+	}
+
+	for _, r := range s {
+		if i == 0 {
+			return option.Some(r)
+		}
+
+		i--
+	}
+
+	return option.None[rune]()
+}
+
+// SplitOnce splits `s` around the first occurrence of `sep`, or
+// returns None if `sep` does not occur.
+func SplitOnce(s, sep string) option.Option[tuple.Pair[string, string]] {
+	if before, after, ok := strings.Cut(s, sep); ok {
+		return option.Some(tuple.NewPair(before, after))
+	}
+
+	return option.None[tuple.Pair[string, string]]()
+}
+
+// RSplitOnce splits `s` around the last occurrence of `sep`, or
+// returns None if `sep` does not occur.
+func RSplitOnce(s, sep string) option.Option[tuple.Pair[string, string]] {
+	i := strings.LastIndex(s, sep)
+	if i < 0 {
+		return option.None[tuple.Pair[string, string]]()
+	}
+
+	return option.Some(tuple.NewPair(s[:i], s[i+len(sep):]))
+}
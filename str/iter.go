@@ -0,0 +1,72 @@
+package str
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+)
+
+// Split returns an Iterator over the substrings of `s` separated by
+// `sep`, yielding the same pieces as `strings.Split` without building
+// a slice. An empty `sep` splits after each UTF-8 sequence.
+func Split(s, sep string) iter.Iterator[string] {
+	if sep == "" {
+		return iter.Func[string](func() option.Option[string] {
+			if s == "" {
+				return option.None[string]()
+			}
+
+			_, size := utf8.DecodeRuneInString(s)
+			piece := s[:size]
+			s = s[size:]
+			return option.Some(piece)
+		})
+	}
+
+	done := false
+	return iter.Func[string](func() option.Option[string] {
+		if done {
+			return option.None[string]()
+		}
+
+		before, after, found := strings.Cut(s, sep)
+		if !found {
+			done = true
+			return option.Some(s)
+		}
+
+		s = after
+		return option.Some(before)
+	})
+}
+
+// Lines returns an Iterator over the lines of `s`. Lines end with
+// "\n" or "\r\n", which are not included; a final line ending does not
+// start an extra, empty line.
+func Lines(s string) iter.Iterator[string] {
+	return iter.Func[string](func() option.Option[string] {
+		if s == "" {
+			return option.None[string]()
+		}
+
+		line, rest, _ := strings.Cut(s, "\n")
+		s = rest
+		return option.Some(strings.TrimSuffix(line, "\r"))
+	})
+}
+
+// Chars returns an Iterator over the runes of `s`. Invalid UTF-8
+// bytes each yield `utf8.RuneError`.
+func Chars(s string) iter.Iterator[rune] {
+	return iter.Func[rune](func() option.Option[rune] {
+		if s == "" {
+			return option.None[rune]()
+		}
+
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		return option.Some(r)
+	})
+}
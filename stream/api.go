@@ -0,0 +1,239 @@
+// Package stream is an implementation of concurrent pipelines over
+// channels of Results. Every element of a Stream is a
+// `result.Result[T, error]`, so failures flow through adapters
+// alongside data and are acted on only by the terminal operation.
+//
+// A Stream carries the context it was created with. Adapters stop,
+// and close their output, when that context is done; terminal
+// operations then return an error Result holding `ctx.Err()`.
+package stream
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Stream is a channel-backed sequence of Results.
+type Stream[T any] struct {
+	ctx context.Context
+	ch  <-chan result.Result[T, error]
+}
+
+// New returns a Stream reading from `ch`. The producer must close
+// `ch` when it is finished, and should stop sending once `ctx` is done.
+func New[T any](ctx context.Context, ch <-chan result.Result[T, error]) Stream[T] {
+	return Stream[T]{ctx: ctx, ch: ch}
+}
+
+// FromSlice returns a Stream of ok Results, one for each of `items`.
+func FromSlice[T any](ctx context.Context, items []T) Stream[T] {
+	out := make(chan result.Result[T, error])
+	go func() {
+		defer close(out)
+		for _, item := range items {
+			if !send(ctx, out, result.Ok(item)) {
+				return
+			}
+		}
+	}()
+
+	return New(ctx, out)
+}
+
+// Chan returns the channel backing the Stream.
+func (s Stream[T]) Chan() <-chan result.Result[T, error] {
+	return s.ch
+}
+
+// Context returns the context the Stream was created with.
+func (s Stream[T]) Context() context.Context {
+	return s.ctx
+}
+
+// Filter returns a Stream of the ok elements for which `pred` returns
+// true. Error elements are passed through untouched.
+func (s Stream[T]) Filter(pred func(T) bool) Stream[T] {
+	return pipe(s, 0, func(r result.Result[T, error], emit func(result.Result[T, error]) bool) bool {
+		if r.IsOk() && !pred(r.Unwrap()) {
+			return true
+		}
+
+		return emit(r)
+	})
+}
+
+// Buffer returns a Stream backed by a channel with room for `n`
+// elements, letting a fast producer run ahead of a slow consumer.
+func (s Stream[T]) Buffer(n int) Stream[T] {
+	return pipe(s, n, func(r result.Result[T, error], emit func(result.Result[T, error]) bool) bool {
+		return emit(r)
+	})
+}
+
+// Map returns a Stream of `fn` applied to each ok element of `s`.
+// Error elements are passed through untouched.
+func Map[T any, U any](s Stream[T], fn func(T) U) Stream[U] {
+	return pipe(s, 0, func(r result.Result[T, error], emit func(result.Result[U, error]) bool) bool {
+		if r.IsErr() {
+			return emit(result.Err[U](r.UnwrapErr()))
+		}
+
+		return emit(result.Ok(fn(r.Unwrap())))
+	})
+}
+
+// TryMap is like `Map` for fallible functions. A failed call becomes
+// an error element.
+func TryMap[T any, U any](s Stream[T], fn func(T) (U, error)) Stream[U] {
+	return pipe(s, 0, func(r result.Result[T, error], emit func(result.Result[U, error]) bool) bool {
+		if r.IsErr() {
+			return emit(result.Err[U](r.UnwrapErr()))
+		}
+
+		return emit(result.Match(fn(r.Unwrap())))
+	})
+}
+
+// Merge returns a Stream of every element of `ss`, in whatever order
+// they arrive. It runs under `ctx` rather than the contexts of `ss`.
+func Merge[T any](ctx context.Context, ss ...Stream[T]) Stream[T] {
+	out := make(chan result.Result[T, error])
+	var wg sync.WaitGroup
+	wg.Add(len(ss))
+	for _, s := range ss {
+		go func(s Stream[T]) {
+			defer wg.Done()
+			forward(ctx, s.ch, func(r result.Result[T, error]) bool { return send(ctx, out, r) })
+		}(s)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return New(ctx, out)
+}
+
+// FanOut calls `fn` on the ok elements of `s` from `workers`
+// goroutines and returns a Stream of the outcomes. Output order is
+// not preserved. Error elements are passed through untouched.
+func FanOut[T any, U any](s Stream[T], workers int, fn func(T) (U, error)) Stream[U] {
+	if workers < 1 {
+		workers = 1
+	}
+
+	out := make(chan result.Result[U, error])
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			forward(s.ctx, s.ch, func(r result.Result[T, error]) bool {
+				if r.IsErr() {
+					return send(s.ctx, out, result.Err[U](r.UnwrapErr()))
+				}
+
+				return send(s.ctx, out, result.Match(fn(r.Unwrap())))
+			})
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	return New(s.ctx, out)
+}
+
+// Collect gathers the ok elements of `s` into a slice. It stops at
+// the first error element, or when the Stream's context is done, and
+// returns that error.
+func (s Stream[T]) Collect() result.Result[[]T, error] {
+	var out []T
+	if err := s.each(func(data T) { out = append(out, data) }); err != nil {
+		return result.Err[[]T](err)
+	}
+
+	return result.Ok(out)
+}
+
+// ForEach calls `fn` on each ok element of `s`. It stops at the
+// first error element, or when the Stream's context is done, and
+// returns that error.
+func (s Stream[T]) ForEach(fn func(T)) result.Result[struct{}, error] {
+	if err := s.each(fn); err != nil {
+		return result.Err[struct{}](err)
+	}
+
+	return result.Ok(struct{}{})
+}
+
+func (s Stream[T]) each(fn func(T)) error {
+	for {
+		select {
+		case r, ok := <-s.ch:
+			if !ok {
+				return nil
+			}
+
+			if r.IsErr() {
+				go drain(s.ch)
+				return r.UnwrapErr()
+			}
+
+			fn(r.Unwrap())
+		case <-s.ctx.Done():
+			go drain(s.ch)
+			return s.ctx.Err()
+		}
+	}
+}
+
+// pipe runs `step` on every element of `s` in a new goroutine,
+// emitting into a new Stream with an `n`-element buffer. `step`
+// returns false to stop early.
+func pipe[T any, U any](s Stream[T], n int, step func(r result.Result[T, error], emit func(result.Result[U, error]) bool) bool) Stream[U] {
+	out := make(chan result.Result[U, error], n)
+	emit := func(r result.Result[U, error]) bool { return send(s.ctx, out, r) }
+	go func() {
+		defer close(out)
+		forward(s.ctx, s.ch, func(r result.Result[T, error]) bool { return step(r, emit) })
+	}()
+
+	return New(s.ctx, out)
+}
+
+// forward calls `fn` on each element received from `in` until `in`
+// is closed, `ctx` is done, or `fn` returns false.
+func forward[T any](ctx context.Context, in <-chan result.Result[T, error], fn func(result.Result[T, error]) bool) {
+	for {
+		select {
+		case r, ok := <-in:
+			if !ok || !fn(r) {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// send delivers `r` on `out`, reporting false if `ctx` is done first.
+func send[T any](ctx context.Context, out chan<- result.Result[T, error], r result.Result[T, error]) bool {
+	select {
+	case out <- r:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// drain discards the rest of `ch` so its producer is not left blocked.
+func drain[T any](ch <-chan T) {
+	for range ch {
+	}
+}
@@ -0,0 +1,136 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Batch returns a Stream of slices of up to `n` ok elements of `s`.
+// A batch is emitted when it is full, or `maxWait` after its first
+// element arrived, whichever comes first; a `maxWait` of zero or less
+// waits for a full batch. A partial batch is flushed before an error
+// element is passed through, and when `s` ends.
+func Batch[T any](s Stream[T], n int, maxWait time.Duration) Stream[[]T] {
+	if n < 1 {
+		n = 1
+	}
+
+	out := make(chan result.Result[[]T, error])
+	go func() {
+		defer close(out)
+
+		var batch []T
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+
+			if len(batch) == 0 {
+				return true
+			}
+
+			full := batch
+			batch = nil
+			return send(s.ctx, out, result.Ok(full))
+		}
+
+		for {
+			select {
+			case r, ok := <-s.ch:
+				if !ok {
+					flush()
+					return
+				}
+
+				if r.IsErr() {
+					if !flush() || !send(s.ctx, out, result.Err[[]T](r.UnwrapErr())) {
+						return
+					}
+
+					continue
+				}
+
+				batch = append(batch, r.Unwrap())
+				if len(batch) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+
+				if len(batch) >= n && !flush() {
+					return
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				if !flush() {
+					return
+				}
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return New(s.ctx, out)
+}
+
+// Window returns a Stream of slices holding the ok elements of `s`
+// that arrived during each consecutive interval of length `d`. Empty
+// windows are skipped. The current window is flushed before an error
+// element is passed through, and when `s` ends. Window panics if `d`
+// is not positive.
+func Window[T any](s Stream[T], d time.Duration) Stream[[]T] {
+	if d <= 0 {
+		panic("stream: non-positive duration for Window")
+	}
+
+	out := make(chan result.Result[[]T, error])
+	go func() {
+		defer close(out)
+
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+
+		var window []T
+		flush := func() bool {
+			if len(window) == 0 {
+				return true
+			}
+
+			full := window
+			window = nil
+			return send(s.ctx, out, result.Ok(full))
+		}
+
+		for {
+			select {
+			case r, ok := <-s.ch:
+				if !ok {
+					flush()
+					return
+				}
+
+				if r.IsErr() {
+					if !flush() || !send(s.ctx, out, result.Err[[]T](r.UnwrapErr())) {
+						return
+					}
+
+					continue
+				}
+
+				window = append(window, r.Unwrap())
+			case <-ticker.C:
+				if !flush() {
+					return
+				}
+			case <-s.ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return New(s.ctx, out)
+}
@@ -0,0 +1,216 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// ErrLagged is the last element a subscriber with the `ErrorOnFull`
+// policy receives when it falls too far behind.
+var ErrLagged = errors.New("stream: subscriber lagged behind broadcast")
+
+// Policy decides what a `Broadcast` does when a subscriber's buffer is
+// full.
+type Policy int
+
+const (
+	// Block waits for the subscriber to make room. A slow subscriber
+	// holds up `Publish`, and so every other subscriber.
+	Block Policy = iota
+	// DropOldest discards the oldest buffered element to make room,
+	// so the subscriber always sees the most recent elements.
+	DropOldest
+	// ErrorOnFull ends the subscription: the oldest buffered element
+	// is discarded, `ErrLagged` is delivered in its place, and the
+	// subscriber's Stream is closed.
+	ErrorOnFull
+)
+
+// Broadcast delivers every published Result to every subscriber. It
+// is safe for concurrent use.
+type Broadcast[T any] struct {
+	mu     sync.Mutex
+	subs   map[*Subscription[T]]struct{}
+	closed bool
+}
+
+// NewBroadcast returns a Broadcast with no subscribers.
+func NewBroadcast[T any]() *Broadcast[T] {
+	return &Broadcast[T]{subs: map[*Subscription[T]]struct{}{}}
+}
+
+// Subscription is one subscriber to a `Broadcast`.
+type Subscription[T any] struct {
+	b      *Broadcast[T]
+	ch     chan result.Result[T, error]
+	stream Stream[T]
+	policy Policy
+	done   chan struct{}
+	stop   func() bool
+	once   sync.Once
+	mu     sync.Mutex
+	closed bool
+}
+
+// Subscribe adds a subscriber whose elements are buffered up to
+// `buffer` deep, with `policy` applied when the buffer is full. The
+// subscription ends when `ctx` is done, `Unsubscribe` is called, or
+// the Broadcast is closed. Subscribing to a closed Broadcast returns a
+// subscription whose Stream is already closed.
+func (b *Broadcast[T]) Subscribe(ctx context.Context, buffer int, policy Policy) *Subscription[T] {
+	sub := &Subscription[T]{
+		b:      b,
+		ch:     make(chan result.Result[T, error], max(buffer, 1)),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+	sub.stream = New(ctx, sub.ch)
+
+	b.mu.Lock()
+	closed := b.closed
+	if !closed {
+		b.subs[sub] = struct{}{}
+	}
+	b.mu.Unlock()
+
+	if closed {
+		sub.Unsubscribe()
+		return sub
+	}
+
+	sub.mu.Lock()
+	sub.stop = context.AfterFunc(ctx, sub.Unsubscribe)
+	sub.mu.Unlock()
+
+	return sub
+}
+
+// Publish delivers `r` to every current subscriber, applying each
+// one's policy. It reports false if the Broadcast is closed.
+func (b *Broadcast[T]) Publish(r result.Result[T, error]) bool {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return false
+	}
+
+	subs := make([]*Subscription[T], 0, len(b.subs))
+	for sub := range b.subs {
+		subs = append(subs, sub)
+	}
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.deliver(r)
+	}
+
+	return true
+}
+
+// Run publishes every element of `s` and closes the Broadcast when `s`
+// ends or its context is done. It blocks until then.
+func (b *Broadcast[T]) Run(s Stream[T]) {
+	defer b.Close()
+	forward(s.ctx, s.ch, b.Publish)
+}
+
+// Close ends every subscription and makes later calls to `Publish`
+// report false. Elements already buffered are still delivered.
+func (b *Broadcast[T]) Close() {
+	b.mu.Lock()
+	b.closed = true
+	subs := b.subs
+	b.subs = map[*Subscription[T]]struct{}{}
+	b.mu.Unlock()
+
+	for sub := range subs {
+		sub.Unsubscribe()
+	}
+}
+
+// Len returns the number of current subscribers.
+func (b *Broadcast[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subs)
+}
+
+// Stream returns the subscriber's elements. It is closed when the
+// subscription ends.
+func (s *Subscription[T]) Stream() Stream[T] {
+	return s.stream
+}
+
+// Unsubscribe ends the subscription and closes its Stream. Elements
+// already buffered are still delivered. It is safe to call more than
+// once.
+func (s *Subscription[T]) Unsubscribe() {
+	s.once.Do(func() {
+		close(s.done)
+
+		s.b.mu.Lock()
+		delete(s.b.subs, s)
+		s.b.mu.Unlock()
+
+		s.mu.Lock()
+		if s.stop != nil {
+			s.stop()
+		}
+		s.closed = true
+		close(s.ch)
+		s.mu.Unlock()
+	})
+}
+
+func (s *Subscription[T]) deliver(r result.Result[T, error]) {
+	if !s.send(r) {
+		s.Unsubscribe()
+	}
+}
+
+// send applies the subscriber's policy to deliver `r`. It reports
+// false if the subscriber lagged under `ErrorOnFull` and must be
+// unsubscribed.
+func (s *Subscription[T]) send(r result.Result[T, error]) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return true
+	}
+
+	if s.policy == Block {
+		select {
+		case s.ch <- r:
+		case <-s.done:
+		}
+		return true
+	}
+
+	for {
+		select {
+		case s.ch <- r:
+			return true
+		default:
+		}
+
+		s.dropOldest()
+		if s.policy == ErrorOnFull {
+			s.ch <- result.Err[T](ErrLagged)
+			s.closed = true
+			return false
+		}
+	}
+}
+
+// dropOldest discards the oldest buffered element, if the subscriber
+// has not taken it in the meantime.
+func (s *Subscription[T]) dropOldest() {
+	select {
+	case <-s.ch:
+	default:
+	}
+}
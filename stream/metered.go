@@ -0,0 +1,50 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Metrics is the progress of a Stream wrapped by `Metered`.
+type Metrics struct {
+	// Processed is the number of ok elements passed through so far.
+	Processed int
+	// Errors is the number of error elements passed through so far.
+	Errors int
+	// Elapsed is the time since the Stream was metered.
+	Elapsed time.Duration
+	// Done reports whether the metered Stream has ended, either
+	// because its input closed or because its context is done.
+	Done bool
+}
+
+// Metered returns a Stream of the elements of `s`, unchanged, calling
+// `report` with the running Metrics after each element and once more
+// with `Done` set when the Stream ends. `report` is called from a
+// single goroutine, in order, and holds up the pipeline while it runs.
+func Metered[T any](s Stream[T], report func(Metrics)) Stream[T] {
+	out := make(chan result.Result[T, error])
+	start := time.Now()
+	go func() {
+		defer close(out)
+
+		var m Metrics
+		forward(s.ctx, s.ch, func(r result.Result[T, error]) bool {
+			if r.IsErr() {
+				m.Errors++
+			} else {
+				m.Processed++
+			}
+
+			m.Elapsed = time.Since(start)
+			report(m)
+			return send(s.ctx, out, r)
+		})
+
+		m.Elapsed, m.Done = time.Since(start), true
+		report(m)
+	}()
+
+	return New(s.ctx, out)
+}
@@ -0,0 +1,51 @@
+// Package synk provides synchronization primitives that
+// report their outcome as a `Result`. It is named to avoid
+// colliding with the standard library's `sync`.
+package synk
+
+import (
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Mode controls how a `OnceResult` treats a failed initialization.
+type Mode = result.OnceMode
+
+const (
+	// CacheErr caches the first outcome, ok or error. This is
+	// the zero value and mirrors `sync.Once`.
+	CacheErr = result.CacheErr
+	// RetryOnErr caches only an ok outcome. An error is returned
+	// to the caller and the next call to `Do` runs its function again.
+	RetryOnErr = result.RetryOnErr
+)
+
+// OnceResult memoizes a fallible initialization, such as opening
+// a database pool or dialing a client. The zero value is ready to
+// use and caches errors; use `NewOnceResult` to pick another `Mode`.
+// A OnceResult must not be copied after first use.
+//
+// It is a `result.OnceResult` for functions in the `(T, error)` style.
+type OnceResult[T any] result.OnceResult[T, error]
+
+// NewOnceResult returns a OnceResult operating in `mode`.
+func NewOnceResult[T any](mode Mode) *OnceResult[T] {
+	if mode == RetryOnErr {
+		return (*OnceResult[T])(result.OnceRetry[T, error](nil))
+	}
+
+	return (*OnceResult[T])(result.Once[T, error](nil))
+}
+
+// Do calls `fn` if and only if no previous call has been cached,
+// and returns the cached Result. Concurrent callers block until
+// the running `fn` returns.
+func (o *OnceResult[T]) Do(fn func() (T, error)) result.Result[T, error] {
+	return (*result.OnceResult[T, error])(o).Do(func() result.Result[T, error] {
+		return result.Match(fn())
+	})
+}
+
+// Done reports whether a Result has been cached.
+func (o *OnceResult[T]) Done() bool {
+	return (*result.OnceResult[T, error])(o).Done()
+}
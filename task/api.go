@@ -0,0 +1,80 @@
+// Package task is an implementation of structured goroutines,
+// loosely modeled on tokio's `task`. A spawned task reports its
+// outcome as a `Result`, turns panics into errors, and can be aborted
+// through its context.
+package task
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// PanicError is the error a task resolves to when its function
+// panics.
+type PanicError = result.PanicError
+
+// JoinHandle is the owner's view of a spawned task.
+type JoinHandle[T any] struct {
+	cancel   context.CancelFunc
+	done     chan struct{}
+	finished uint32
+	res      result.Result[T, error]
+}
+
+// Spawn runs `fn` in a new goroutine and returns a handle to it.
+// `fn` receives a context that is cancelled by `Abort`.
+func Spawn[T any](fn func(ctx context.Context) (T, error)) *JoinHandle[T] {
+	return SpawnCtx(context.Background(), fn)
+}
+
+// SpawnCtx is like `Spawn`, but the task's context is derived from
+// `ctx`, so cancelling `ctx` also aborts the task.
+func SpawnCtx[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) *JoinHandle[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	h := &JoinHandle[T]{cancel: cancel, done: make(chan struct{})}
+	go func() {
+		defer close(h.done)
+		defer cancel()
+		h.res = result.CatchResult(func() result.Result[T, error] { return result.Match(fn(ctx)) })
+		atomic.StoreUint32(&h.finished, 1)
+	}()
+
+	return h
+}
+
+// Join blocks until the task returns and gives its Result. A panic
+// in the task is returned as a `*PanicError`.
+func (h *JoinHandle[T]) Join() result.Result[T, error] {
+	<-h.done
+	return h.res
+}
+
+// JoinCtx is like `Join`, but gives up when `ctx` is done, returning
+// an error Result holding `ctx.Err()`. The task keeps running.
+func (h *JoinHandle[T]) JoinCtx(ctx context.Context) result.Result[T, error] {
+	select {
+	case <-h.done:
+		return h.res
+	case <-ctx.Done():
+		return result.Err[T](ctx.Err())
+	}
+}
+
+// Abort cancels the task's context. Go cannot stop a goroutine from
+// the outside, so the task ends only when its function notices the
+// cancellation and returns; `Join` still waits for that.
+func (h *JoinHandle[T]) Abort() {
+	h.cancel()
+}
+
+// IsFinished reports whether the task has returned.
+func (h *JoinHandle[T]) IsFinished() bool {
+	return atomic.LoadUint32(&h.finished) == 1
+}
+
+// Done returns a channel that is closed when the task returns.
+func (h *JoinHandle[T]) Done() <-chan struct{} {
+	return h.done
+}
@@ -0,0 +1,136 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// Group runs a collection of fallible functions and gathers their
+// errors, in the manner of `errgroup.Group`. Panics in those functions
+// are recovered and recorded as `*PanicError`s. The zero value is a
+// Group with no limit that does not cancel anything on failure.
+type Group struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+	sem    chan struct{}
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// WithContext returns a new Group and a context derived from `ctx`.
+// The context is cancelled the first time a function in the Group
+// fails, or when `Wait` returns, whichever comes first.
+func WithContext(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of functions running at once to `n`.
+// A negative `n` removes the limit. SetLimit must not be called while
+// functions are running.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs `fn` in a new goroutine, blocking first until the Group's
+// limit allows it.
+func (g *Group) Go(fn func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.start(fn)
+}
+
+// TryGo runs `fn` in a new goroutine only if the Group's limit allows
+// it without blocking, and reports whether it did.
+func (g *Group) TryGo(fn func() error) bool {
+	if g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+		default:
+			return false
+		}
+	}
+
+	g.start(fn)
+	return true
+}
+
+// GoResult is `Go` for functions that report their outcome as a Result.
+func (g *Group) GoResult(fn func() result.Result[struct{}, error]) {
+	g.Go(func() error {
+		if res := fn(); res.IsErr() {
+			return res.UnwrapErr()
+		}
+
+		return nil
+	})
+}
+
+func (g *Group) start(fn func() error) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+
+		if err := g.call(fn); err != nil {
+			g.mu.Lock()
+			g.errs = append(g.errs, err)
+			g.mu.Unlock()
+
+			if g.cancel != nil {
+				g.cancel()
+			}
+		}
+	}()
+}
+
+func (g *Group) call(fn func() error) error {
+	return result.CatchResult(func() result.Result[struct{}, error] {
+		return result.Match(struct{}{}, fn())
+	}).AsError()
+}
+
+// Wait blocks until every function in the Group has returned, then
+// returns the first error recorded, if any.
+func (g *Group) Wait() result.Result[struct{}, error] {
+	errs := g.wait()
+	if len(errs) > 0 {
+		return result.Err[struct{}](errs[0])
+	}
+
+	return result.Ok(struct{}{})
+}
+
+// WaitAll is like `Wait`, but returns every recorded error joined
+// into one with `errors.Join`, in the order they occurred.
+func (g *Group) WaitAll() result.Result[struct{}, error] {
+	if err := errors.Join(g.wait()...); err != nil {
+		return result.Err[struct{}](err)
+	}
+
+	return result.Ok(struct{}{})
+}
+
+func (g *Group) wait() []error {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel()
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.errs
+}
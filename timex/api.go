@@ -0,0 +1,86 @@
+// Package timex wraps fallible `time` operations in `Option` and
+// `Result`. The standard library silently wraps or saturates on
+// overflow and leaves layout guessing to the caller; these helpers
+// make such failures part of the return type instead.
+package timex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// ErrNoLayouts is returned by `Parse` when it is given no layouts.
+var ErrNoLayouts = errors.New("timex: no layouts given")
+
+// ParseError reports a string that matched none of the layouts given
+// to `Parse`. `Errs` holds the error for each layout, in order.
+type ParseError struct {
+	Input   string
+	Layouts []string
+	Errs    []error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf(
+		"timex: cannot parse %q with any of the layouts %s",
+		e.Input,
+		strings.Join(e.Layouts, ", "),
+	)
+}
+
+func (e *ParseError) Unwrap() []error {
+	return e.Errs
+}
+
+// Parse parses `s` with each of `layouts` in turn and returns the first
+// time that parses. If none do, the error is a `*ParseError` holding
+// every layout's failure.
+func Parse(layouts []string, s string) result.Result[time.Time, error] {
+	if len(layouts) == 0 {
+		return result.Err[time.Time](ErrNoLayouts)
+	}
+
+	errs := make([]error, 0, len(layouts))
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return result.Ok(t)
+		}
+		errs = append(errs, err)
+	}
+
+	return result.Err[time.Time](error(&ParseError{Input: s, Layouts: layouts, Errs: errs}))
+}
+
+// CheckedAdd returns `t+d`, or `None` if the sum cannot be represented
+// by a `time.Time`.
+func CheckedAdd(t time.Time, d time.Duration) option.Option[time.Time] {
+	sum := t.Add(d)
+	if sum.Sub(t) != d {
+		return option.None[time.Time]()
+	}
+
+	return option.Some(sum)
+}
+
+// CheckedSub returns `t-u`, or `None` if the difference cannot be
+// represented by a `time.Duration`. Unlike `time.Time.Sub`, it never
+// saturates to the maximum or minimum duration.
+func CheckedSub(t, u time.Time) option.Option[time.Duration] {
+	d := t.Sub(u)
+	if !u.Add(d).Equal(t) {
+		return option.None[time.Duration]()
+	}
+
+	return option.Some(d)
+}
+
+// DurationFromString parses `s` with `time.ParseDuration`.
+func DurationFromString(s string) result.Result[time.Duration, error] {
+	return result.Match(time.ParseDuration(s))
+}
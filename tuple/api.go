@@ -0,0 +1,41 @@
+// Package tuple is an implementation of fixed-size groupings of
+// values, loosely modeled on Rust's tuples.
+package tuple
+
+// Pair groups two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a Pair holding `a` and `b`.
+func NewPair[A any, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Unpack returns the values of the Pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns a Pair with the values exchanged.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// Triple groups three values of possibly different types.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a Triple holding `a`, `b`, and `c`.
+func NewTriple[A any, B any, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// Unpack returns the values of the Triple.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
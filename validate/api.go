@@ -0,0 +1,233 @@
+// Package validate checks the fields of a value and reports every
+// failure at once. Where chaining `Result`s stops at the first error,
+// `Struct` runs every check and collects the failures, each addressed
+// by its field path:
+//
+//	res := validate.Struct(u,
+//		validate.Field("name", u.Name, validate.NonEmpty[string]()),
+//		validate.Field("age", u.Age, validate.Range(0, 150)),
+//		validate.Nested("address", u.Address, validateAddress),
+//	)
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jwhittle933/rs.go/cmp"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// FieldError reports a field that failed a rule.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors is every field failure from one validation, in the order the
+// checks were given.
+type Errors []*FieldError
+
+func (es Errors) Error() string {
+	msgs := make([]string, len(es))
+	for i, e := range es {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the field errors, so that `errors.Is` and `errors.As`
+// match against each.
+func (es Errors) Unwrap() []error {
+	out := make([]error, len(es))
+	for i, e := range es {
+		out[i] = e
+	}
+
+	return out
+}
+
+// Paths returns the path of each failing field.
+func (es Errors) Paths() []string {
+	paths := make([]string, len(es))
+	for i, e := range es {
+		paths[i] = e.Path
+	}
+
+	return paths
+}
+
+// Rule checks a value, returning nil if it passes.
+type Rule[T any] func(v T) error
+
+// Check is one part of a validation, built with `Field`, `Nested`, or
+// `Each`, that reports the failures it finds.
+type Check func() Errors
+
+// Struct runs every check and returns `v` if all pass, or an `Errors`
+// listing every failing field.
+func Struct[T any](v T, checks ...Check) result.Result[T, error] {
+	var errs Errors
+	for _, c := range checks {
+		errs = append(errs, c()...)
+	}
+
+	if len(errs) > 0 {
+		return result.Err[T](error(errs))
+	}
+
+	return result.Ok(v)
+}
+
+// Field checks `value` against `rules` in order, reporting the first
+// that fails under `path`. Later rules are not run once one fails, so
+// that, for example, an empty string is not also reported as failing a
+// pattern.
+func Field[T any](path string, value T, rules ...Rule[T]) Check {
+	return func() Errors {
+		for _, rule := range rules {
+			if err := rule(value); err != nil {
+				return Errors{{Path: path, Err: err}}
+			}
+		}
+
+		return nil
+	}
+}
+
+// Nested checks `value` with `fn`, which is typically a function that
+// calls `Struct` on it. The paths of its field errors are prefixed
+// with `path` and a dot; any other error is reported under `path`.
+func Nested[T any](path string, value T, fn func(T) result.Result[T, error]) Check {
+	return func() Errors {
+		res := fn(value)
+		if res.IsOk() {
+			return nil
+		}
+
+		return prefix(path, res.UnwrapErr())
+	}
+}
+
+// Each checks every element of `values` with `fn`, reporting failures
+// under `path[i]`.
+func Each[T any](path string, values []T, fn func(T) result.Result[T, error]) Check {
+	return func() Errors {
+		var errs Errors
+		for i, v := range values {
+			if res := fn(v); res.IsErr() {
+				errs = append(errs, prefix(fmt.Sprintf("%s[%d]", path, i), res.UnwrapErr())...)
+			}
+		}
+
+		return errs
+	}
+}
+
+func prefix(path string, err error) Errors {
+	var inner Errors
+	if !errors.As(err, &inner) {
+		return Errors{{Path: path, Err: err}}
+	}
+
+	out := make(Errors, len(inner))
+	for i, e := range inner {
+		out[i] = &FieldError{Path: path + "." + e.Path, Err: e.Err}
+	}
+
+	return out
+}
+
+// NonEmpty fails for an empty string.
+func NonEmpty[T ~string]() Rule[T] {
+	return func(v T) error {
+		if v == "" {
+			return errors.New("must not be empty")
+		}
+
+		return nil
+	}
+}
+
+// NonZero fails for the zero value of `T`.
+func NonZero[T comparable]() Rule[T] {
+	return func(v T) error {
+		var zero T
+		if v == zero {
+			return errors.New("must be set")
+		}
+
+		return nil
+	}
+}
+
+// Range fails for values outside `[lo, hi]`.
+func Range[T cmp.Ordered](lo, hi T) Rule[T] {
+	return func(v T) error {
+		if v < lo || v > hi {
+			return fmt.Errorf("must be between %v and %v", lo, hi)
+		}
+
+		return nil
+	}
+}
+
+// MinLen fails for strings of fewer than `n` characters.
+func MinLen[T ~string](n int) Rule[T] {
+	return func(v T) error {
+		if utf8.RuneCountInString(string(v)) < n {
+			return fmt.Errorf("must be at least %d characters", n)
+		}
+
+		return nil
+	}
+}
+
+// MaxLen fails for strings of more than `n` characters.
+func MaxLen[T ~string](n int) Rule[T] {
+	return func(v T) error {
+		if utf8.RuneCountInString(string(v)) > n {
+			return fmt.Errorf("must be at most %d characters", n)
+		}
+
+		return nil
+	}
+}
+
+// MatchRegex fails for strings that do not match `pattern`. The
+// pattern is compiled once, when the rule is made; it panics if the
+// pattern is invalid.
+func MatchRegex[T ~string](pattern string) Rule[T] {
+	re := regexp.MustCompile(pattern)
+	return func(v T) error {
+		if !re.MatchString(string(v)) {
+			return fmt.Errorf("must match %s", pattern)
+		}
+
+		return nil
+	}
+}
+
+// Func makes a rule from a predicate, failing with `msg` when it
+// returns false.
+func Func[T any](ok func(T) bool, msg string) Rule[T] {
+	return func(v T) error {
+		if !ok(v) {
+			return errors.New(msg)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,247 @@
+// Package vec is an implementation of a growable array,
+// loosely modeled on Rust's `Vec`. Accessors return `Option`s and
+// structural operations return `Result`s, so an out-of-range index
+// is a value to handle rather than a panic.
+package vec
+
+import (
+	"fmt"
+
+	"github.com/jwhittle933/rs.go/iter"
+	"github.com/jwhittle933/rs.go/option"
+	"github.com/jwhittle933/rs.go/result"
+)
+
+// IndexError reports an index outside the bounds of a Vec.
+type IndexError struct {
+	Index int
+	Len   int
+}
+
+func (e IndexError) Error() string {
+	return fmt.Sprintf("vec: index %d out of range with length %d", e.Index, e.Len)
+}
+
+// RangeError reports a range that is inverted or outside the
+// bounds of a Vec.
+type RangeError struct {
+	From int
+	To   int
+	Len  int
+}
+
+func (e RangeError) Error() string {
+	return fmt.Sprintf("vec: range [%d:%d] out of range with length %d", e.From, e.To, e.Len)
+}
+
+// Vec is a growable, bounds-checked list of `T`.
+// The zero value is an empty Vec ready to use.
+type Vec[T any] struct {
+	items []T
+}
+
+// New returns an empty Vec.
+func New[T any]() *Vec[T] {
+	return &Vec[T]{}
+}
+
+// WithCapacity returns an empty Vec with room for `n` items.
+func WithCapacity[T any](n int) *Vec[T] {
+	return &Vec[T]{items: make([]T, 0, n)}
+}
+
+// From returns a Vec holding `items`. The slice is used as the
+// backing array, not copied.
+func From[T any](items ...T) *Vec[T] {
+	return &Vec[T]{items: items}
+}
+
+// Len returns the number of items.
+func (v *Vec[T]) Len() int {
+	return len(v.items)
+}
+
+// IsEmpty reports whether the Vec has no items.
+func (v *Vec[T]) IsEmpty() bool {
+	return len(v.items) == 0
+}
+
+// AsSlice returns the backing slice. Mutating it mutates the Vec.
+func (v *Vec[T]) AsSlice() []T {
+	return v.items
+}
+
+// Get returns the item at `i`, or None if `i` is out of range.
+func (v *Vec[T]) Get(i int) option.Option[T] {
+	if i < 0 || i >= len(v.items) {
+		return option.None[T]()
+	}
+
+	return option.Some(v.items[i])
+}
+
+// First returns the first item, or None if the Vec is empty.
+func (v *Vec[T]) First() option.Option[T] {
+	return v.Get(0)
+}
+
+// Last returns the last item, or None if the Vec is empty.
+func (v *Vec[T]) Last() option.Option[T] {
+	return v.Get(len(v.items) - 1)
+}
+
+// Push appends `item` to the end of the Vec.
+func (v *Vec[T]) Push(item T) {
+	v.items = append(v.items, item)
+}
+
+// Pop removes and returns the last item, or None if the Vec is empty.
+func (v *Vec[T]) Pop() option.Option[T] {
+	if len(v.items) == 0 {
+		return option.None[T]()
+	}
+
+	last := v.items[len(v.items)-1]
+	v.items = v.clear(len(v.items)-1, len(v.items))
+	return option.Some(last)
+}
+
+// Insert places `item` at `i`, shifting later items to the right.
+// `i` may equal `Len()` to append. Any other out-of-range index
+// returns an `IndexError`.
+func (v *Vec[T]) Insert(i int, item T) result.Result[struct{}, error] {
+	if i < 0 || i > len(v.items) {
+		return result.Err[struct{}](error(IndexError{Index: i, Len: len(v.items)}))
+	}
+
+	var zero T
+	v.items = append(v.items, zero)
+	copy(v.items[i+1:], v.items[i:])
+	v.items[i] = item
+	return result.Ok(struct{}{})
+}
+
+// Remove removes and returns the item at `i`, shifting later items
+// to the left. An out-of-range index returns an `IndexError`.
+func (v *Vec[T]) Remove(i int) result.Result[T, error] {
+	if i < 0 || i >= len(v.items) {
+		return result.Err[T](error(IndexError{Index: i, Len: len(v.items)}))
+	}
+
+	item := v.items[i]
+	copy(v.items[i:], v.items[i+1:])
+	v.items = v.clear(len(v.items)-1, len(v.items))
+	return result.Ok(item)
+}
+
+// SwapRemove removes and returns the item at `i` in O(1) by moving
+// the last item into its place. It does not preserve order. An
+// out-of-range index returns an `IndexError`.
+func (v *Vec[T]) SwapRemove(i int) result.Result[T, error] {
+	if i < 0 || i >= len(v.items) {
+		return result.Err[T](error(IndexError{Index: i, Len: len(v.items)}))
+	}
+
+	item := v.items[i]
+	v.items[i] = v.items[len(v.items)-1]
+	v.items = v.clear(len(v.items)-1, len(v.items))
+	return result.Ok(item)
+}
+
+// Swap exchanges the items at `i` and `j`. If either index is out of
+// range, the Vec is unchanged and an `IndexError` is returned.
+func (v *Vec[T]) Swap(i, j int) result.Result[struct{}, error] {
+	for _, idx := range []int{i, j} {
+		if idx < 0 || idx >= len(v.items) {
+			return result.Err[struct{}](error(IndexError{Index: idx, Len: len(v.items)}))
+		}
+	}
+
+	v.items[i], v.items[j] = v.items[j], v.items[i]
+	return result.Ok(struct{}{})
+}
+
+// SplitOff moves the items in `[at, Len())` into a new Vec and returns
+// it, leaving `[0, at)` in `v`. `at` may equal `Len()`, which returns an
+// empty Vec. Any other out-of-range index returns an `IndexError`.
+func (v *Vec[T]) SplitOff(at int) result.Result[*Vec[T], error] {
+	if at < 0 || at > len(v.items) {
+		return result.Err[*Vec[T]](error(IndexError{Index: at, Len: len(v.items)}))
+	}
+
+	tail := make([]T, len(v.items)-at)
+	copy(tail, v.items[at:])
+	v.items = v.clear(at, len(v.items))
+	return result.Ok(&Vec[T]{items: tail})
+}
+
+// Retain keeps only the items for which `pred` returns true,
+// preserving their order.
+func (v *Vec[T]) Retain(pred func(T) bool) {
+	kept := 0
+	for _, item := range v.items {
+		if pred(item) {
+			v.items[kept] = item
+			kept++
+		}
+	}
+
+	v.items = v.clear(kept, len(v.items))
+}
+
+// DedupBy removes consecutive items for which `same` reports true,
+// keeping the first of each run. `same` is called with the previous
+// kept item and the candidate.
+func (v *Vec[T]) DedupBy(same func(a, b T) bool) {
+	if len(v.items) < 2 {
+		return
+	}
+
+	kept := 1
+	for _, item := range v.items[1:] {
+		if !same(v.items[kept-1], item) {
+			v.items[kept] = item
+			kept++
+		}
+	}
+
+	v.items = v.clear(kept, len(v.items))
+}
+
+// Dedup removes consecutive equal items from `v`. It is a package
+// function rather than a method because it needs `T` to be comparable.
+func Dedup[T comparable](v *Vec[T]) {
+	v.DedupBy(func(a, b T) bool { return a == b })
+}
+
+// Drain removes the items in `[from, to)` and returns an Iterator
+// over them. The items are removed immediately, whether or not the
+// Iterator is consumed. An invalid range returns a `RangeError`.
+func (v *Vec[T]) Drain(from, to int) result.Result[iter.Iterator[T], error] {
+	if from < 0 || to > len(v.items) || from > to {
+		return result.Err[iter.Iterator[T]](error(RangeError{From: from, To: to, Len: len(v.items)}))
+	}
+
+	drained := make([]T, to-from)
+	copy(drained, v.items[from:to])
+
+	n := copy(v.items[from:], v.items[to:])
+	v.items = v.clear(from+n, len(v.items))
+	return result.Ok(iter.FromSlice(drained))
+}
+
+// Clear removes every item, keeping the allocated capacity.
+func (v *Vec[T]) Clear() {
+	v.items = v.clear(0, len(v.items))
+}
+
+// clear zeroes items[from:to] so they can be garbage collected
+// and returns items[:from].
+func (v *Vec[T]) clear(from, to int) []T {
+	var zero T
+	for i := from; i < to; i++ {
+		v.items[i] = zero
+	}
+
+	return v.items[:from]
+}